@@ -89,6 +89,17 @@ func NewAccountMeta(
 	}
 }
 
+// Equals returns true if both account metas have the same pubkey and flags.
+// Two nil metas are equal.
+func (meta *AccountMeta) Equals(other *AccountMeta) bool {
+	if meta == nil || other == nil {
+		return meta == other
+	}
+	return meta.PublicKey.Equals(other.PublicKey) &&
+		meta.IsWritable == other.IsWritable &&
+		meta.IsSigner == other.IsSigner
+}
+
 func (a *AccountMeta) less(act *AccountMeta) bool {
 	if a.IsSigner != act.IsSigner {
 		return a.IsSigner
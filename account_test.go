@@ -158,6 +158,23 @@ func TestMeta(t *testing.T) {
 	require.True(t, meta.IsWritable)
 }
 
+func TestAccountMeta_Equals(t *testing.T) {
+	pkey := MustPublicKeyFromBase58("SysvarS1otHashes111111111111111111111111111")
+	other := MustPublicKeyFromBase58("SysvarC1ock11111111111111111111111111111111")
+
+	require.True(t, Meta(pkey).WRITE().Equals(Meta(pkey).WRITE()))
+	require.True(t, NewAccountMeta(pkey, true, true).Equals(Meta(pkey).SIGNER().WRITE()))
+
+	require.False(t, Meta(pkey).Equals(Meta(other)))
+	require.False(t, Meta(pkey).Equals(Meta(pkey).WRITE()))
+	require.False(t, Meta(pkey).Equals(Meta(pkey).SIGNER()))
+
+	var nilMeta *AccountMeta
+	require.True(t, nilMeta.Equals(nil))
+	require.False(t, nilMeta.Equals(Meta(pkey)))
+	require.False(t, Meta(pkey).Equals(nil))
+}
+
 func TestSplitFrom(t *testing.T) {
 	slice := make(AccountMetaSlice, 0)
 	slice = append(slice, Meta(BPFLoaderDeprecatedProgramID))
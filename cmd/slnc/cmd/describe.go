@@ -0,0 +1,83 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+)
+
+// describeTransaction returns a one-line, human-readable summary
+// for each of the instructions of the transaction that can be summarized.
+func describeTransaction(tx *solana.Transaction) []string {
+	var out []string
+	for _, inst := range tx.Message.Instructions {
+		progKey, err := tx.ResolveProgramIDIndex(inst.ProgramIDIndex)
+		if err != nil || !progKey.Equals(system.ProgramID) {
+			continue
+		}
+		decoded, err := system.DecodeInstruction(inst.ResolveInstructionAccounts(&tx.Message), inst.Data)
+		if err != nil {
+			continue
+		}
+		if line, ok := describeSystemInstruction(decoded); ok {
+			out = append(out, line)
+		}
+	}
+	return out
+}
+
+func describeSystemInstruction(inst *system.Instruction) (string, bool) {
+	switch impl := inst.Impl.(type) {
+	case *system.Transfer:
+		if impl.Lamports == nil {
+			return "", false
+		}
+		return fmt.Sprintf(
+			"Transfer %s SOL from %s to %s",
+			formatLamportsAsSOL(*impl.Lamports),
+			impl.GetFundingAccount().PublicKey,
+			impl.GetRecipientAccount().PublicKey,
+		), true
+	case *system.CreateAccount:
+		if impl.Lamports == nil || impl.Space == nil || impl.Owner == nil {
+			return "", false
+		}
+		return fmt.Sprintf(
+			"CreateAccount %s owned by %s with %d bytes, funded with %s SOL from %s",
+			impl.GetNewAccount().PublicKey,
+			*impl.Owner,
+			*impl.Space,
+			formatLamportsAsSOL(*impl.Lamports),
+			impl.GetFundingAccount().PublicKey,
+		), true
+	}
+	return "", false
+}
+
+// formatLamportsAsSOL formats the lamports as a SOL amount,
+// without trailing zeros (e.g. 1500000000 => "1.5").
+func formatLamportsAsSOL(lamports uint64) string {
+	whole := lamports / solana.LAMPORTS_PER_SOL
+	fraction := lamports % solana.LAMPORTS_PER_SOL
+	if fraction == 0 {
+		return strconv.FormatUint(whole, 10)
+	}
+	return fmt.Sprintf("%d.%s", whole, strings.TrimRight(fmt.Sprintf("%09d", fraction), "0"))
+}
@@ -0,0 +1,61 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribeTransaction(t *testing.T) {
+	from := solana.MustPublicKeyFromBase58("7xLk17EQQ5KLDLDe44wCmupJKJjTGd8hs3eSVVhCx932")
+	to := solana.MustPublicKeyFromBase58("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM")
+	newAccount := solana.MustPublicKeyFromBase58("8LjUgMjzZuHj8VdyxzkmLLQVmW4C3gd56md1nLd76TNW")
+
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{
+			system.NewTransferInstruction(1_500_000_000, from, to).Build(),
+			system.NewCreateAccountInstruction(2_039_280, 165, solana.TokenProgramID, from, newAccount).Build(),
+		},
+		solana.MustHashFromBase58("A9QnpgfhCkmiBSjgBuWk76Wo3HxzxvDopUq9x6UUMmjn"),
+		solana.TransactionPayer(from),
+	)
+	require.NoError(t, err)
+
+	// Round-trip through the wire format, as the CLI does with fetched transactions.
+	raw, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	decoded, err := solana.TransactionFromDecoder(bin.NewBinDecoder(raw))
+	require.NoError(t, err)
+
+	require.Equal(t,
+		[]string{
+			"Transfer 1.5 SOL from 7xLk17EQQ5KLDLDe44wCmupJKJjTGd8hs3eSVVhCx932 to 9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM",
+			"CreateAccount 8LjUgMjzZuHj8VdyxzkmLLQVmW4C3gd56md1nLd76TNW owned by TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA with 165 bytes, funded with 0.00203928 SOL from 7xLk17EQQ5KLDLDe44wCmupJKJjTGd8hs3eSVVhCx932",
+		},
+		describeTransaction(decoded),
+	)
+}
+
+func TestFormatLamportsAsSOL(t *testing.T) {
+	require.Equal(t, "0", formatLamportsAsSOL(0))
+	require.Equal(t, "0.000000001", formatLamportsAsSOL(1))
+	require.Equal(t, "1", formatLamportsAsSOL(solana.LAMPORTS_PER_SOL))
+	require.Equal(t, "1.5", formatLamportsAsSOL(1_500_000_000))
+}
@@ -23,6 +23,8 @@ import (
 	"os"
 
 	"github.com/gagliardetto/solana-go"
+	_ "github.com/gagliardetto/solana-go/programs/computebudget"
+	_ "github.com/gagliardetto/solana-go/programs/memo"
 	_ "github.com/gagliardetto/solana-go/programs/serum"
 	_ "github.com/gagliardetto/solana-go/programs/system"
 	_ "github.com/gagliardetto/solana-go/programs/token"
@@ -75,7 +77,13 @@ var getTransactionsCmd = &cobra.Command{
 				return fmt.Errorf("unable to get confirmed transaction with signature %q: %s", cs.Signature, ct.Meta.Err)
 			}
 
-			_, err = ct.MustGetTransaction().EncodeTree(text.NewTreeEncoder(os.Stdout, text.Bold("INSTRUCTIONS")))
+			tx := ct.MustGetTransaction()
+			for _, line := range describeTransaction(tx) {
+				text.EncoderColorGreen.Print("Summary: ")
+				fmt.Println(line)
+			}
+
+			_, err = tx.EncodeTree(text.NewTreeEncoder(os.Stdout, text.Bold("INSTRUCTIONS")))
 			if err != nil {
 				panic(err)
 			}
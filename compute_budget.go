@@ -0,0 +1,61 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"encoding/binary"
+)
+
+// Compute budget program instruction discriminators.
+const (
+	computeBudgetSetComputeUnitLimit uint8 = 2
+	computeBudgetSetComputeUnitPrice uint8 = 3
+)
+
+// ReadComputeBudget scans the instructions of the provided transaction
+// for compute budget program instructions, and returns the compute unit limit
+// and the compute unit price (in micro-lamports per compute unit) they set.
+// A nil value is returned for a setting that is not present in the transaction.
+// If an instruction appears more than once, the last one wins.
+func ReadComputeBudget(tx *Transaction) (limit *uint32, microLamportsPerCU *uint64) {
+	if tx == nil {
+		return nil, nil
+	}
+	for _, inst := range tx.Message.Instructions {
+		if int(inst.ProgramIDIndex) >= len(tx.Message.AccountKeys) {
+			continue
+		}
+		if !tx.Message.AccountKeys[inst.ProgramIDIndex].Equals(ComputeBudget) {
+			continue
+		}
+		data := inst.Data
+		if len(data) == 0 {
+			continue
+		}
+		switch data[0] {
+		case computeBudgetSetComputeUnitLimit:
+			if len(data) >= 5 {
+				v := binary.LittleEndian.Uint32(data[1:5])
+				limit = &v
+			}
+		case computeBudgetSetComputeUnitPrice:
+			if len(data) >= 9 {
+				v := binary.LittleEndian.Uint64(data[1:9])
+				microLamportsPerCU = &v
+			}
+		}
+	}
+	return limit, microLamportsPerCU
+}
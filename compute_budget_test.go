@@ -0,0 +1,68 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadComputeBudget(t *testing.T) {
+	payer := NewWallet().PublicKey()
+	blockhash := MustHashFromBase58("A9QnpgfhCkmiBSjgBuWk76Wo3HxzxvDopUq9x6UUMmjn")
+	transfer := NewInstruction(
+		SystemProgramID,
+		AccountMetaSlice{
+			Meta(payer).WRITE().SIGNER(),
+			Meta(NewWallet().PublicKey()).WRITE(),
+		},
+		[]byte{2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0},
+	)
+
+	t.Run("with limit and price", func(t *testing.T) {
+		tx, err := NewTransaction(
+			[]Instruction{
+				// SetComputeUnitLimit(200_000)
+				NewInstruction(ComputeBudget, nil, []byte{2, 0x40, 0x0d, 0x03, 0x00}),
+				// SetComputeUnitPrice(10_000)
+				NewInstruction(ComputeBudget, nil, []byte{3, 0x10, 0x27, 0, 0, 0, 0, 0, 0}),
+				transfer,
+			},
+			blockhash,
+			TransactionPayer(payer),
+		)
+		require.NoError(t, err)
+
+		limit, price := ReadComputeBudget(tx)
+		require.NotNil(t, limit)
+		require.Equal(t, uint32(200_000), *limit)
+		require.NotNil(t, price)
+		require.Equal(t, uint64(10_000), *price)
+	})
+
+	t.Run("without compute budget instructions", func(t *testing.T) {
+		tx, err := NewTransaction(
+			[]Instruction{transfer},
+			blockhash,
+			TransactionPayer(payer),
+		)
+		require.NoError(t, err)
+
+		limit, price := ReadComputeBudget(tx)
+		require.Nil(t, limit)
+		require.Nil(t, price)
+	})
+}
@@ -0,0 +1,102 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"fmt"
+
+	bin "github.com/gagliardetto/binary"
+)
+
+// COption is the `Option<T>` layout used by the SPL programs in account state:
+// a little-endian u32 tag (0 for None, 1 for Some) followed by the value,
+// which is always present (zeroed for None) so that the layout has a fixed size.
+const (
+	cOptionNone uint32 = 0
+	cOptionSome uint32 = 1
+)
+
+// EncodeCOptionPublicKey writes the provided public key as a COption<Pubkey>;
+// a nil key is encoded as None.
+func EncodeCOptionPublicKey(encoder *bin.Encoder, key *PublicKey) error {
+	if key == nil {
+		if err := encoder.WriteUint32(cOptionNone, bin.LE); err != nil {
+			return err
+		}
+		return encoder.WriteBytes(make([]byte, PublicKeyLength), false)
+	}
+	if err := encoder.WriteUint32(cOptionSome, bin.LE); err != nil {
+		return err
+	}
+	return encoder.WriteBytes(key[:], false)
+}
+
+// DecodeCOptionPublicKey reads a COption<Pubkey>; None is returned as nil.
+func DecodeCOptionPublicKey(decoder *bin.Decoder) (*PublicKey, error) {
+	tag, err := decodeCOptionTag(decoder)
+	if err != nil {
+		return nil, err
+	}
+	v, err := decoder.ReadNBytes(PublicKeyLength)
+	if err != nil {
+		return nil, err
+	}
+	if tag == cOptionNone {
+		return nil, nil
+	}
+	return PublicKeyFromBytes(v).ToPointer(), nil
+}
+
+// EncodeCOptionUint64 writes the provided value as a COption<u64>;
+// a nil value is encoded as None.
+func EncodeCOptionUint64(encoder *bin.Encoder, value *uint64) error {
+	if value == nil {
+		if err := encoder.WriteUint32(cOptionNone, bin.LE); err != nil {
+			return err
+		}
+		return encoder.WriteUint64(0, bin.LE)
+	}
+	if err := encoder.WriteUint32(cOptionSome, bin.LE); err != nil {
+		return err
+	}
+	return encoder.WriteUint64(*value, bin.LE)
+}
+
+// DecodeCOptionUint64 reads a COption<u64>; None is returned as nil.
+func DecodeCOptionUint64(decoder *bin.Decoder) (*uint64, error) {
+	tag, err := decodeCOptionTag(decoder)
+	if err != nil {
+		return nil, err
+	}
+	v, err := decoder.ReadUint64(bin.LE)
+	if err != nil {
+		return nil, err
+	}
+	if tag == cOptionNone {
+		return nil, nil
+	}
+	return &v, nil
+}
+
+func decodeCOptionTag(decoder *bin.Decoder) (uint32, error) {
+	tag, err := decoder.ReadUint32(bin.LE)
+	if err != nil {
+		return 0, err
+	}
+	if tag != cOptionNone && tag != cOptionSome {
+		return 0, fmt.Errorf("invalid COption tag: %d", tag)
+	}
+	return tag, nil
+}
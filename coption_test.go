@@ -0,0 +1,71 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"bytes"
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCOptionPublicKey(t *testing.T) {
+	key := MustPublicKeyFromBase58("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	{
+		buf := new(bytes.Buffer)
+		require.NoError(t, EncodeCOptionPublicKey(bin.NewBinEncoder(buf), &key))
+		require.Equal(t, append([]byte{1, 0, 0, 0}, key[:]...), buf.Bytes())
+
+		got, err := DecodeCOptionPublicKey(bin.NewBinDecoder(buf.Bytes()))
+		require.NoError(t, err)
+		require.Equal(t, &key, got)
+	}
+	{
+		buf := new(bytes.Buffer)
+		require.NoError(t, EncodeCOptionPublicKey(bin.NewBinEncoder(buf), nil))
+		require.Equal(t, make([]byte, 4+PublicKeyLength), buf.Bytes())
+
+		got, err := DecodeCOptionPublicKey(bin.NewBinDecoder(buf.Bytes()))
+		require.NoError(t, err)
+		require.Nil(t, got)
+	}
+}
+
+func TestCOptionUint64(t *testing.T) {
+	{
+		value := uint64(2039280)
+		buf := new(bytes.Buffer)
+		require.NoError(t, EncodeCOptionUint64(bin.NewBinEncoder(buf), &value))
+		require.Equal(t, []byte{1, 0, 0, 0, 0xf0, 0x1d, 0x1f, 0, 0, 0, 0, 0}, buf.Bytes())
+
+		got, err := DecodeCOptionUint64(bin.NewBinDecoder(buf.Bytes()))
+		require.NoError(t, err)
+		require.Equal(t, &value, got)
+	}
+	{
+		buf := new(bytes.Buffer)
+		require.NoError(t, EncodeCOptionUint64(bin.NewBinEncoder(buf), nil))
+		require.Equal(t, make([]byte, 12), buf.Bytes())
+
+		got, err := DecodeCOptionUint64(bin.NewBinDecoder(buf.Bytes()))
+		require.NoError(t, err)
+		require.Nil(t, got)
+	}
+	{
+		_, err := DecodeCOptionUint64(bin.NewBinDecoder([]byte{2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}))
+		require.EqualError(t, err, "invalid COption tag: 2")
+	}
+}
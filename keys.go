@@ -18,6 +18,8 @@
 package solana
 
 import (
+	"bytes"
+	"container/list"
 	"crypto"
 	"crypto/ed25519"
 	crypto_rand "crypto/rand"
@@ -26,11 +28,21 @@ import (
 	"fmt"
 	"io/ioutil"
 	"math"
+	"sync"
 
 	"filippo.io/edwards25519"
 	"github.com/mr-tron/base58"
 )
 
+// Signer is implemented by anything that can sign messages on behalf of a public key,
+// e.g. a PrivateKey held in memory, a hardware wallet or a remote signing service.
+type Signer interface {
+	PublicKey() PublicKey
+	Sign(message []byte) (Signature, error)
+}
+
+var _ Signer = PrivateKey(nil)
+
 type PrivateKey []byte
 
 func MustPrivateKeyFromBase58(in string) PrivateKey {
@@ -78,6 +90,12 @@ func NewRandomPrivateKey() (PrivateKey, error) {
 	return PrivateKey(priv), nil
 }
 
+// NewPrivateKeyFromSeed deterministically derives a PrivateKey
+// from the provided 32-byte ed25519 seed.
+func NewPrivateKeyFromSeed(seed [32]byte) PrivateKey {
+	return PrivateKey(ed25519.NewKeyFromSeed(seed[:]))
+}
+
 func (k PrivateKey) Sign(payload []byte) (Signature, error) {
 	p := ed25519.PrivateKey(k)
 	signData, err := p.Sign(crypto_rand.Reader, payload, crypto.Hash(0))
@@ -350,16 +368,14 @@ const (
 // Ported from https://github.com/solana-labs/solana/blob/216983c50e0a618facc39aa07472ba6d23f1b33a/sdk/program/src/pubkey.rs#L159
 func CreateWithSeed(base PublicKey, seed string, owner PublicKey) (PublicKey, error) {
 	if len(seed) > MaxSeedLength {
-		return PublicKey{}, errors.New("Max seed length exceeded")
+		return PublicKey{}, ErrMaxSeedLengthExceeded
 	}
 
-	// let owner = owner.as_ref();
-	// if owner.len() >= PDA_MARKER.len() {
-	//     let slice = &owner[owner.len() - PDA_MARKER.len()..];
-	//     if slice == PDA_MARKER {
-	//         return Err(PubkeyError::IllegalOwner);
-	//     }
-	// }
+	// The owner must not end with the PDA marker, or the derived address
+	// could collide with a program address.
+	if bytes.HasSuffix(owner[:], []byte(PDA_MARKER)) {
+		return PublicKey{}, ErrIllegalOwner
+	}
 
 	b := make([]byte, 0, 64+len(seed))
 	b = append(b, base[:]...)
@@ -373,6 +389,9 @@ const PDA_MARKER = "ProgramDerivedAddress"
 
 var ErrMaxSeedLengthExceeded = errors.New("Max seed length exceeded")
 
+// ErrIllegalOwner is returned by CreateWithSeed when the owner ends with the PDA marker.
+var ErrIllegalOwner = errors.New("Provided owner is not allowed")
+
 // Create a program address.
 // Ported from https://github.com/solana-labs/solana/blob/216983c50e0a618facc39aa07472ba6d23f1b33a/sdk/program/src/pubkey.rs#L204
 func CreateProgramAddress(seeds [][]byte, programID PublicKey) (PublicKey, error) {
@@ -427,26 +446,190 @@ func FindProgramAddress(seed [][]byte, programID PublicKey) (PublicKey, uint8, e
 func FindAssociatedTokenAddress(
 	wallet PublicKey,
 	mint PublicKey,
+) (PublicKey, uint8, error) {
+	return FindAssociatedTokenAddressWithProgram(
+		wallet,
+		mint,
+		TokenProgramID,
+	)
+}
+
+// FindAssociatedTokenAddressWithProgram returns the associated token account address
+// of the provided wallet for a mint owned by the provided token program.
+// The token program is part of the seeds, so the associated token accounts
+// of Token-2022 mints (Token2022ProgramID) differ from the ones of legacy mints.
+func FindAssociatedTokenAddressWithProgram(
+	wallet PublicKey,
+	mint PublicKey,
+	tokenProgramID PublicKey,
 ) (PublicKey, uint8, error) {
 	return findAssociatedTokenAddressAndBumpSeed(
 		wallet,
 		mint,
+		tokenProgramID,
 		SPLAssociatedTokenAccountProgramID,
 	)
 }
 
+// IsAssociatedTokenAccount returns whether the provided account is the canonical
+// associated token account of the wallet for the mint owned by the provided token program.
+func IsAssociatedTokenAccount(
+	account PublicKey,
+	wallet PublicKey,
+	mint PublicKey,
+	tokenProgramID PublicKey,
+) bool {
+	ata, _, err := FindAssociatedTokenAddressWithProgram(wallet, mint, tokenProgramID)
+	if err != nil {
+		return false
+	}
+	return ata.Equals(account)
+}
+
+// AssociatedTokenAddressForMints returns the associated token account addresses
+// of the provided wallet for each of the provided mints, in the same order.
+// Recently derived addresses are cached (see SetAssociatedTokenAddressCacheSize),
+// so repeated lookups for the same wallet/mint pair don't need to search for a bump seed again.
+func AssociatedTokenAddressForMints(
+	wallet PublicKey,
+	mints []PublicKey,
+) ([]PublicKey, error) {
+	out := make([]PublicKey, len(mints))
+	for i, mint := range mints {
+		addr, _, err := FindAssociatedTokenAddress(wallet, mint)
+		if err != nil {
+			return nil, fmt.Errorf("error while deriving ATA for mint %s: %w", mint, err)
+		}
+		out[i] = addr
+	}
+	return out, nil
+}
+
+type ataCacheKey struct {
+	wallet       PublicKey
+	tokenProgram PublicKey
+	mint         PublicKey
+	programID    PublicKey
+}
+
+type ataCacheValue struct {
+	address  PublicKey
+	bumpSeed uint8
+}
+
+// DefaultAssociatedTokenAddressCacheSize is the default maximum number
+// of derived associated token addresses that are cached.
+const DefaultAssociatedTokenAddressCacheSize = 4096
+
+// ataCache holds the most recently derived associated token addresses.
+var ataCache = newATACache(DefaultAssociatedTokenAddressCacheSize)
+
+// SetAssociatedTokenAddressCacheSize sets the maximum number of derived
+// associated token addresses that are cached; the least recently used
+// ones are evicted first. A size of zero disables the cache.
+func SetAssociatedTokenAddressCacheSize(size int) {
+	ataCache.resize(size)
+}
+
+type ataCacheEntry struct {
+	key   ataCacheKey
+	value ataCacheValue
+}
+
+// ataCacheLRU is a fixed-size LRU cache of associated token addresses.
+type ataCacheLRU struct {
+	mu      sync.Mutex
+	size    int
+	entries map[ataCacheKey]*list.Element
+	// The front is the most recently used entry.
+	order *list.List
+}
+
+func newATACache(size int) *ataCacheLRU {
+	return &ataCacheLRU{
+		size:    size,
+		entries: make(map[ataCacheKey]*list.Element),
+		order:   list.New(),
+	}
+}
+
+func (c *ataCacheLRU) get(key ataCacheKey) (ataCacheValue, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return ataCacheValue{}, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*ataCacheEntry).value, true
+}
+
+func (c *ataCacheLRU) add(key ataCacheKey, value ataCacheValue) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.size <= 0 {
+		return
+	}
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*ataCacheEntry).value = value
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&ataCacheEntry{key: key, value: value})
+	c.evict()
+}
+
+func (c *ataCacheLRU) resize(size int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.size = size
+	c.evict()
+}
+
+func (c *ataCacheLRU) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// evict removes the least recently used entries beyond the size of the cache.
+func (c *ataCacheLRU) evict() {
+	for c.order.Len() > 0 && c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*ataCacheEntry).key)
+	}
+}
+
 func findAssociatedTokenAddressAndBumpSeed(
 	walletAddress PublicKey,
 	splTokenMintAddress PublicKey,
+	tokenProgramID PublicKey,
 	programID PublicKey,
 ) (PublicKey, uint8, error) {
-	return FindProgramAddress([][]byte{
+	key := ataCacheKey{
+		wallet:       walletAddress,
+		tokenProgram: tokenProgramID,
+		mint:         splTokenMintAddress,
+		programID:    programID,
+	}
+	if cached, ok := ataCache.get(key); ok {
+		return cached.address, cached.bumpSeed, nil
+	}
+
+	address, bumpSeed, err := FindProgramAddress([][]byte{
 		walletAddress[:],
-		TokenProgramID[:],
+		tokenProgramID[:],
 		splTokenMintAddress[:],
 	},
 		programID,
 	)
+	if err != nil {
+		return PublicKey{}, 0, err
+	}
+
+	ataCache.add(key, ataCacheValue{address: address, bumpSeed: bumpSeed})
+	return address, bumpSeed, nil
 }
 
 // FindTokenMetadataAddress returns the token metadata program-derived address given a SPL token mint address.
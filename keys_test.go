@@ -22,6 +22,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"flag"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -131,6 +132,26 @@ func TestPrivateKeyFromSolanaKeygenFile(t *testing.T) {
 	}
 }
 
+func TestNewPrivateKeyFromSeed(t *testing.T) {
+	// RFC 8032, section 7.1, TEST 1.
+	seedBytes, err := hex.DecodeString("9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60")
+	require.NoError(t, err)
+	expectedPublicKey, err := hex.DecodeString("d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a")
+	require.NoError(t, err)
+
+	var seed [32]byte
+	copy(seed[:], seedBytes)
+
+	first := NewPrivateKeyFromSeed(seed)
+	second := NewPrivateKeyFromSeed(seed)
+	require.Equal(t, first, second)
+	require.Equal(t, PublicKeyFromBytes(expectedPublicKey), first.PublicKey())
+	require.Equal(t, first.PublicKey(), second.PublicKey())
+
+	seed[0] ^= 0xff
+	require.NotEqual(t, first.PublicKey(), NewPrivateKeyFromSeed(seed).PublicKey())
+}
+
 func TestPublicKey_MarshalText(t *testing.T) {
 	keyString := "4wBqpZM9k69W87zdYXT2bRtLViWqTiJV3i2Kn9q7S6j"
 	keyParsed := MustPublicKeyFromBase58(keyString)
@@ -337,6 +358,31 @@ func TestCreateWithSeed(t *testing.T) {
 		require.NoError(t, err)
 		require.True(t, got.Equals(MustPublicKeyFromBase58("9h1HyLCW5dZnBVap8C5egQ9Z6pHyjsh5MNy83iPqqRuq")))
 	}
+	{
+		// sha256(base || seed || owner)
+		got, err := CreateWithSeed(
+			MustPublicKeyFromBase58("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM"),
+			"stake:0",
+			StakeProgramID,
+		)
+		require.NoError(t, err)
+		require.Equal(t, MustPublicKeyFromBase58("8BseXT9EtoEhBTKFFYkwTnjKSUZwhtmdKY2Jrj8j45Rt"), got)
+	}
+	{
+		// The seed limit is in bytes: 8 4-byte characters fit, 9 do not.
+		_, err := CreateWithSeed(PublicKey{}, strings.Repeat("\U0010FFFF", 8), PublicKey{})
+		require.NoError(t, err)
+		_, err = CreateWithSeed(PublicKey{}, strings.Repeat("\U0010FFFF", 8)+"x", PublicKey{})
+		require.ErrorIs(t, err, ErrMaxSeedLengthExceeded)
+		_, err = CreateWithSeed(PublicKey{}, "", PublicKey{})
+		require.NoError(t, err)
+	}
+	{
+		var owner PublicKey
+		copy(owner[PublicKeyLength-len(PDA_MARKER):], PDA_MARKER)
+		_, err := CreateWithSeed(PublicKey{}, "seed", owner)
+		require.ErrorIs(t, err, ErrIllegalOwner)
+	}
 }
 
 func TestCreateProgramAddressFromRust(t *testing.T) {
@@ -549,3 +595,139 @@ func TestFindTokenMetadataAddress(t *testing.T) {
 	assert.Equal(t, metadataPDA, MustPublicKeyFromBase58("GfihrEYCPrvUyrMyMQPdhGEStxa9nKEK2Wfn9iK4AZq2"))
 	assert.Equal(t, bumpSeed, uint8(0xfd))
 }
+
+func TestAssociatedTokenAddressForMints(t *testing.T) {
+	wallet := MustPublicKeyFromBase58("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM")
+	mints := []PublicKey{
+		MustPublicKeyFromBase58("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"), // USDC
+		SolMint,
+		MustPublicKeyFromBase58("Es9vMFrzaCERmJfrF4H2FYD4KCoNkY11McCe8BenwNYB"), // USDT
+	}
+	expected := []PublicKey{
+		MustPublicKeyFromBase58("FGETo8T8wMcN2wCjav8VK6eh3dLk63evNDPxzLSJra8B"),
+		MustPublicKeyFromBase58("8LjUgMjzZuHj8VdyxzkmLLQVmW4C3gd56md1nLd76TNW"),
+		MustPublicKeyFromBase58("TB5FCqbNsnuLQgEjUuPaT9qtVPTT4U1A8rvi7qzEj2M"),
+	}
+
+	// First call derives, the second one is served from the cache.
+	for i := 0; i < 2; i++ {
+		got, err := AssociatedTokenAddressForMints(wallet, mints)
+		require.NoError(t, err)
+		require.Equal(t, expected, got)
+	}
+
+	for i, mint := range mints {
+		addr, _, err := FindProgramAddress(
+			[][]byte{wallet[:], TokenProgramID[:], mint[:]},
+			SPLAssociatedTokenAccountProgramID,
+		)
+		require.NoError(t, err)
+		require.Equal(t, expected[i], addr)
+	}
+
+	got, err := AssociatedTokenAddressForMints(wallet, nil)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestSetAssociatedTokenAddressCacheSize(t *testing.T) {
+	defer SetAssociatedTokenAddressCacheSize(DefaultAssociatedTokenAddressCacheSize)
+
+	wallet := MustPublicKeyFromBase58("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM")
+	usdc := MustPublicKeyFromBase58("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	usdt := MustPublicKeyFromBase58("Es9vMFrzaCERmJfrF4H2FYD4KCoNkY11McCe8BenwNYB")
+	keyOf := func(mint PublicKey) ataCacheKey {
+		return ataCacheKey{
+			wallet:       wallet,
+			tokenProgram: TokenProgramID,
+			mint:         mint,
+			programID:    SPLAssociatedTokenAccountProgramID,
+		}
+	}
+
+	SetAssociatedTokenAddressCacheSize(0)
+	require.Zero(t, ataCache.len())
+
+	SetAssociatedTokenAddressCacheSize(2)
+	_, err := AssociatedTokenAddressForMints(wallet, []PublicKey{usdc, SolMint})
+	require.NoError(t, err)
+	// Use USDC again, so that the wrapped SOL ATA is the least recently used.
+	_, _, err = FindAssociatedTokenAddress(wallet, usdc)
+	require.NoError(t, err)
+	got, err := AssociatedTokenAddressForMints(wallet, []PublicKey{usdt})
+	require.NoError(t, err)
+	require.Equal(t, []PublicKey{MustPublicKeyFromBase58("TB5FCqbNsnuLQgEjUuPaT9qtVPTT4U1A8rvi7qzEj2M")}, got)
+
+	require.Equal(t, 2, ataCache.len())
+	_, ok := ataCache.get(keyOf(SolMint))
+	require.False(t, ok)
+	cached, ok := ataCache.get(keyOf(usdc))
+	require.True(t, ok)
+	require.Equal(t, MustPublicKeyFromBase58("FGETo8T8wMcN2wCjav8VK6eh3dLk63evNDPxzLSJra8B"), cached.address)
+
+	// Disabling the cache empties it; the derivation still works.
+	SetAssociatedTokenAddressCacheSize(0)
+	require.Zero(t, ataCache.len())
+	addr, _, err := FindAssociatedTokenAddress(wallet, usdc)
+	require.NoError(t, err)
+	require.Equal(t, cached.address, addr)
+	require.Zero(t, ataCache.len())
+}
+
+func TestFindAssociatedTokenAddressWithProgram(t *testing.T) {
+	wallet := MustPublicKeyFromBase58("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM")
+	{
+		usdc := MustPublicKeyFromBase58("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+		addr, bump, err := FindAssociatedTokenAddressWithProgram(wallet, usdc, TokenProgramID)
+		require.NoError(t, err)
+		require.Equal(t, MustPublicKeyFromBase58("FGETo8T8wMcN2wCjav8VK6eh3dLk63evNDPxzLSJra8B"), addr)
+		require.Equal(t, uint8(254), bump)
+
+		legacyAddr, legacyBump, err := FindAssociatedTokenAddress(wallet, usdc)
+		require.NoError(t, err)
+		require.Equal(t, addr, legacyAddr)
+		require.Equal(t, bump, legacyBump)
+	}
+	{
+		pyusd := MustPublicKeyFromBase58("2b1kV6DkPAnxd5ixfnxCpjxmKwqjjaYmCZfHsFu24GXo") // Token-2022 mint
+		addr, bump, err := FindAssociatedTokenAddressWithProgram(wallet, pyusd, Token2022ProgramID)
+		require.NoError(t, err)
+		require.Equal(t, MustPublicKeyFromBase58("897krAvWH3RbymaCYE3o9emopUwocieHuKTUk9nySpq6"), addr)
+		require.Equal(t, uint8(255), bump)
+
+		// The legacy token program gives a different address.
+		legacyAddr, _, err := FindAssociatedTokenAddress(wallet, pyusd)
+		require.NoError(t, err)
+		require.NotEqual(t, addr, legacyAddr)
+	}
+}
+
+func TestIsAssociatedTokenAccount(t *testing.T) {
+	wallet := MustPublicKeyFromBase58("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM")
+	usdc := MustPublicKeyFromBase58("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	ata := MustPublicKeyFromBase58("FGETo8T8wMcN2wCjav8VK6eh3dLk63evNDPxzLSJra8B")
+
+	require.True(t, IsAssociatedTokenAccount(ata, wallet, usdc, TokenProgramID))
+	// Same wallet and mint, but a different token program.
+	require.False(t, IsAssociatedTokenAccount(ata, wallet, usdc, Token2022ProgramID))
+	// A non-canonical token account owned by the wallet.
+	require.False(t, IsAssociatedTokenAccount(NewWallet().PublicKey(), wallet, usdc, TokenProgramID))
+	// The ATA of another wallet.
+	require.False(t, IsAssociatedTokenAccount(ata, NewWallet().PublicKey(), usdc, TokenProgramID))
+}
+
+func BenchmarkAssociatedTokenAddressForMints(b *testing.B) {
+	wallet := NewWallet().PublicKey()
+	mints := make([]PublicKey, 20)
+	for i := range mints {
+		mints[i] = NewWallet().PublicKey()
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := AssociatedTokenAddressForMints(wallet, mints)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
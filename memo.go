@@ -0,0 +1,49 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+// MaxMemoSize is the largest memo, in bytes, that fits in a transaction
+// together with a single signature.
+const MaxMemoSize = 566
+
+// WithMemo returns a copy of the provided instructions, with a Memo program instruction
+// carrying the provided memo appended. If signer is not the zero public key,
+// it is attached to the memo as a required signer.
+func WithMemo(instructions []Instruction, memo string, signer PublicKey) ([]Instruction, error) {
+	if len(memo) == 0 {
+		return nil, errors.New("memo is empty")
+	}
+	if len(memo) > MaxMemoSize {
+		return nil, fmt.Errorf("memo is too long: %d bytes, max is %d", len(memo), MaxMemoSize)
+	}
+	if !utf8.ValidString(memo) {
+		return nil, errors.New("memo is not valid UTF-8")
+	}
+
+	accounts := AccountMetaSlice{}
+	if !signer.IsZero() {
+		accounts = append(accounts, Meta(signer).SIGNER())
+	}
+	out := make([]Instruction, 0, len(instructions)+1)
+	out = append(out, instructions...)
+	out = append(out, NewInstruction(MemoProgramID, accounts, []byte(memo)))
+	return out, nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithMemo(t *testing.T) {
+	payer := NewWallet().PublicKey()
+	transfer := NewInstruction(
+		SystemProgramID,
+		AccountMetaSlice{Meta(payer).WRITE().SIGNER()},
+		[]byte{2, 0, 0, 0},
+	)
+	instructions := []Instruction{transfer}
+
+	got, err := WithMemo(instructions, "order-1234", payer)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Len(t, instructions, 1)
+	require.Equal(t, transfer, got[0])
+
+	memo := got[1]
+	require.Equal(t, MemoProgramID, memo.ProgramID())
+	require.Equal(t, []*AccountMeta{{PublicKey: payer, IsSigner: true}}, memo.Accounts())
+	data, err := memo.Data()
+	require.NoError(t, err)
+	require.Equal(t, []byte("order-1234"), data)
+
+	// Without signer.
+	got, err = WithMemo(nil, "hello", PublicKey{})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Empty(t, got[0].Accounts())
+
+	_, err = WithMemo(instructions, "", payer)
+	require.Error(t, err)
+	_, err = WithMemo(instructions, strings.Repeat("a", MaxMemoSize+1), payer)
+	require.Error(t, err)
+	_, err = WithMemo(instructions, strings.Repeat("a", MaxMemoSize), payer)
+	require.NoError(t, err)
+	_, err = WithMemo(instructions, "\xff", payer)
+	require.Error(t, err)
+}
@@ -19,6 +19,7 @@ package solana
 
 import (
 	"encoding/base64"
+	"errors"
 	"fmt"
 
 	bin "github.com/gagliardetto/binary"
@@ -72,6 +73,9 @@ type Message struct {
 	AccountKeys []PublicKey `json:"accountKeys"`
 
 	// Details the account types and signatures required by the transaction.
+	// It is computed from the accounts of the instructions when the transaction is built
+	// (see NewTransaction), and is useful to debug "missing signature" and
+	// "account not writable" errors.
 	Header MessageHeader `json:"header"`
 
 	// A base-58 encoded hash of a recent block in the ledger used to
@@ -363,6 +367,11 @@ func (mx *Message) UnmarshalV0(decoder *bin.Decoder) (err error) {
 	if err != nil {
 		return fmt.Errorf("failed to read address table lookups length: %w", err)
 	}
+	// Each lookup takes at least 34 bytes:
+	// the table address and the lengths of the index lists.
+	if err := checkCompactArrayLen(decoder, int(addressTableLookupsLen), PublicKeyLength+2, "address table lookups length"); err != nil {
+		return err
+	}
 	if addressTableLookupsLen > 0 {
 		mx.addressTableLookups = make([]MessageAddressTableLookup, addressTableLookupsLen)
 		for i := 0; i < int(addressTableLookupsLen); i++ {
@@ -377,6 +386,9 @@ func (mx *Message) UnmarshalV0(decoder *bin.Decoder) (err error) {
 			if err != nil {
 				return fmt.Errorf("failed to read writable indexes length: %w", err)
 			}
+			if err := checkCompactArrayLen(decoder, writableIndexesLen, 1, "writable indexes length"); err != nil {
+				return err
+			}
 			mx.addressTableLookups[i].WritableIndexes = make([]byte, writableIndexesLen)
 			_, err = decoder.Read(mx.addressTableLookups[i].WritableIndexes)
 			if err != nil {
@@ -388,6 +400,9 @@ func (mx *Message) UnmarshalV0(decoder *bin.Decoder) (err error) {
 			if err != nil {
 				return fmt.Errorf("failed to read readonly indexes length: %w", err)
 			}
+			if err := checkCompactArrayLen(decoder, readonlyIndexesLen, 1, "readonly indexes length"); err != nil {
+				return err
+			}
 			mx.addressTableLookups[i].ReadonlyIndexes = make([]byte, readonlyIndexesLen)
 			_, err = decoder.Read(mx.addressTableLookups[i].ReadonlyIndexes)
 			if err != nil {
@@ -418,6 +433,9 @@ func (mx *Message) UnmarshalLegacy(decoder *bin.Decoder) (err error) {
 		if err != nil {
 			return fmt.Errorf("unable to decode numAccountKeys: %w", err)
 		}
+		if err := checkCompactArrayLen(decoder, numAccountKeys, PublicKeyLength, "numAccountKeys"); err != nil {
+			return err
+		}
 		mx.AccountKeys = make([]PublicKey, numAccountKeys)
 		for i := 0; i < numAccountKeys; i++ {
 			_, err := decoder.Read(mx.AccountKeys[i][:])
@@ -437,6 +455,11 @@ func (mx *Message) UnmarshalLegacy(decoder *bin.Decoder) (err error) {
 		if err != nil {
 			return fmt.Errorf("unable to decode numInstructions: %w", err)
 		}
+		// Each instruction takes at least 3 bytes:
+		// the program ID index and the lengths of the accounts and data.
+		if err := checkCompactArrayLen(decoder, numInstructions, 3, "numInstructions"); err != nil {
+			return err
+		}
 		mx.Instructions = make([]CompiledInstruction, numInstructions)
 		for instructionIndex := 0; instructionIndex < numInstructions; instructionIndex++ {
 			programIDIndex, err := decoder.ReadUint8()
@@ -450,6 +473,9 @@ func (mx *Message) UnmarshalLegacy(decoder *bin.Decoder) (err error) {
 				if err != nil {
 					return fmt.Errorf("unable to decode numAccounts for ix[%d]: %w", instructionIndex, err)
 				}
+				if err := checkCompactArrayLen(decoder, numAccounts, 1, fmt.Sprintf("numAccounts for ix[%d]", instructionIndex)); err != nil {
+					return err
+				}
 				mx.Instructions[instructionIndex].Accounts = make([]uint16, numAccounts)
 				for i := 0; i < numAccounts; i++ {
 					accountIndex, err := decoder.ReadUint8()
@@ -476,6 +502,16 @@ func (mx *Message) UnmarshalLegacy(decoder *bin.Decoder) (err error) {
 	return nil
 }
 
+// checkCompactArrayLen returns an error if the decoder doesn't have enough
+// remaining data for `count` elements of at least `minElemSize` bytes each,
+// so that a malicious length prefix can't cause a large allocation.
+func checkCompactArrayLen(decoder *bin.Decoder, count int, minElemSize int, name string) error {
+	if count < 0 || count*minElemSize > decoder.Remaining() {
+		return fmt.Errorf("invalid %s: %d elements declared, but only %d bytes remaining", name, count, decoder.Remaining())
+	}
+	return nil
+}
+
 func (m Message) checkPreconditions() {
 	// if this is versioned,
 	// and there are > 0 lookups,
@@ -500,6 +536,85 @@ func (m Message) AccountMetaList() AccountMetaSlice {
 	return out
 }
 
+// DecompileInstructions turns the compiled instructions of the message back
+// into instructions, with the accounts resolved from the account keys
+// and their signer/writable flags derived from the header.
+//
+// For versioned messages that use address table lookups, the address tables
+// must have been set with SetAddressTables; otherwise, the loaded addresses
+// can be provided to DecompileInstructionsWithLoadedAddresses.
+func (mx *Message) DecompileInstructions() ([]Instruction, error) {
+	if mx.IsVersioned() && mx.addressTableLookups.NumLookups() > 0 && mx.addressTables == nil {
+		return nil, fmt.Errorf("cannot decompile instructions: address tables are not set")
+	}
+	writable, readonly, err := mx.loadedAddresses()
+	if err != nil {
+		return nil, err
+	}
+	return mx.DecompileInstructionsWithLoadedAddresses(writable, readonly)
+}
+
+// loadedAddresses returns the accounts loaded from the address tables,
+// in the order used by the runtime: the writable accounts of all the lookups,
+// then the readonly ones.
+func (mx *Message) loadedAddresses() (writable, readonly PublicKeySlice, err error) {
+	if !mx.IsVersioned() || mx.addressTables == nil {
+		return nil, nil, nil
+	}
+	for _, lookup := range mx.addressTableLookups {
+		table, ok := mx.addressTables[lookup.AccountKey]
+		if !ok {
+			return nil, nil, fmt.Errorf("address table lookup not found for account: %v", lookup.AccountKey)
+		}
+		for _, idx := range lookup.WritableIndexes {
+			if int(idx) >= len(table) {
+				return nil, nil, fmt.Errorf("address table lookup index out of range: %v", idx)
+			}
+			writable = append(writable, table[idx])
+		}
+	}
+	for _, lookup := range mx.addressTableLookups {
+		table := mx.addressTables[lookup.AccountKey]
+		for _, idx := range lookup.ReadonlyIndexes {
+			if int(idx) >= len(table) {
+				return nil, nil, fmt.Errorf("address table lookup index out of range: %v", idx)
+			}
+			readonly = append(readonly, table[idx])
+		}
+	}
+	return writable, readonly, nil
+}
+
+// DecompileInstructionsWithLoadedAddresses is like DecompileInstructions,
+// but uses the provided accounts loaded from address tables
+// (e.g. the `loadedAddresses` of the transaction meta returned by the RPC).
+func (mx *Message) DecompileInstructionsWithLoadedAddresses(writable, readonly PublicKeySlice) ([]Instruction, error) {
+	metas := mx.staticAccountMetas()
+	for _, key := range writable {
+		metas = append(metas, &AccountMeta{PublicKey: key, IsWritable: true})
+	}
+	for _, key := range readonly {
+		metas = append(metas, &AccountMeta{PublicKey: key})
+	}
+
+	out := make([]Instruction, len(mx.Instructions))
+	for i, ci := range mx.Instructions {
+		if int(ci.ProgramIDIndex) >= len(metas) {
+			return nil, fmt.Errorf("instruction %d: program ID index %d out of range", i, ci.ProgramIDIndex)
+		}
+		accounts := make(AccountMetaSlice, len(ci.Accounts))
+		for j, idx := range ci.Accounts {
+			if int(idx) >= len(metas) {
+				return nil, fmt.Errorf("instruction %d: account index %d out of range", i, idx)
+			}
+			meta := *metas[idx]
+			accounts[j] = &meta
+		}
+		out[i] = NewInstruction(metas[ci.ProgramIDIndex].PublicKey, accounts, []byte(ci.Data))
+	}
+	return out, nil
+}
+
 func (m Message) IsVersioned() bool {
 	return m.version != MessageVersionLegacy
 }
@@ -527,6 +642,53 @@ func (m Message) Writable() (out PublicKeySlice) {
 	return out
 }
 
+// WritableAccounts returns the pubkeys of the accounts that the message write-locks:
+// the writable static accounts (except the ones invoked as programs),
+// followed by the writable accounts loaded from the address tables (for versioned messages).
+// NOTE: for versioned messages with address table lookups, the address tables
+// must have been set with SetAddressTables; an error is returned if they
+// don't contain the accounts loaded by the lookups.
+func (m Message) WritableAccounts() (PublicKeySlice, error) {
+	writable, _, err := m.accountsByLock()
+	return writable, err
+}
+
+// ReadonlyAccounts returns the pubkeys of the accounts that the message read-locks:
+// the readonly static accounts (including the ones invoked as programs),
+// followed by the readonly accounts loaded from the address tables (for versioned messages).
+// NOTE: for versioned messages with address table lookups, the address tables
+// must have been set with SetAddressTables; an error is returned if they
+// don't contain the accounts loaded by the lookups.
+func (m Message) ReadonlyAccounts() (PublicKeySlice, error) {
+	_, readonly, err := m.accountsByLock()
+	return readonly, err
+}
+
+func (m Message) accountsByLock() (writable, readonly PublicKeySlice, err error) {
+	if m.IsVersioned() && m.addressTableLookups.NumLookups() > 0 && len(m.addressTables) == 0 {
+		return nil, nil, errors.New("cannot resolve the accounts loaded from address tables: address tables not set")
+	}
+	// Like the runtime, demote the accounts invoked as programs to readonly.
+	invoked := make(map[uint16]bool, len(m.Instructions))
+	for _, ci := range m.Instructions {
+		invoked[ci.ProgramIDIndex] = true
+	}
+	for index, meta := range m.staticAccountMetas() {
+		if meta.IsWritable && !invoked[uint16(index)] {
+			writable = append(writable, meta.PublicKey)
+		} else {
+			readonly = append(readonly, meta.PublicKey)
+		}
+	}
+	loadedWritable, loadedReadonly, err := m.loadedAddresses()
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot resolve the accounts loaded from address tables: %w", err)
+	}
+	writable = append(writable, loadedWritable...)
+	readonly = append(readonly, loadedReadonly...)
+	return writable, readonly, nil
+}
+
 func (m Message) ResolveProgramIDIndex(programIDIndex uint16) (PublicKey, error) {
 	m.checkPreconditions()
 	if int(programIDIndex) < len(m.AccountKeys) {
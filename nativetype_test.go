@@ -19,6 +19,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/mr-tron/base58"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -377,3 +378,66 @@ func TestSignatureVerify(t *testing.T) {
 		}
 	}
 }
+
+func TestSetZstdDecoderOptions(t *testing.T) {
+	defer SetZstdDecoderOptions()
+	SetZstdDecoderOptions(zstd.WithDecoderConcurrency(1))
+
+	var data Data
+	require.NoError(t, data.UnmarshalJSON([]byte(`["KLUv/QQAWQAAaGVsbG8td29ybGTcLcaB", "base64+zstd"]`)))
+	require.Equal(t, []byte("hello-world"), data.Content)
+}
+
+// zstdAccountDataJSON returns the JSON of a "base64+zstd" encoded
+// account data of the provided size.
+func zstdAccountDataJSON(b *testing.B, size int) []byte {
+	content := make([]byte, size)
+	for i := range content {
+		content[i] = byte(i % 7)
+	}
+	enc, err := zstd.NewWriter(nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer enc.Close()
+	compressed := enc.EncodeAll(content, nil)
+	return []byte(`["` + base64.StdEncoding.EncodeToString(compressed) + `","base64+zstd"]`)
+}
+
+func BenchmarkData_UnmarshalJSON_Base64Zstd(b *testing.B) {
+	in := zstdAccountDataJSON(b, 10*1024)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var data Data
+		if err := data.UnmarshalJSON(in); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkData_UnmarshalJSON_Base64Zstd_PerCallDecoder decodes the same data
+// creating a new decoder for each call, for comparison with the pooled decoders.
+func BenchmarkData_UnmarshalJSON_Base64Zstd_PerCallDecoder(b *testing.B) {
+	in := zstdAccountDataJSON(b, 10*1024)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var parts []string
+		if err := json.Unmarshal(in, &parts); err != nil {
+			b.Fatal(err)
+		}
+		raw, err := base64.StdEncoding.DecodeString(parts[0])
+		if err != nil {
+			b.Fatal(err)
+		}
+		dec, err := zstd.NewReader(nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := dec.DecodeAll(raw, nil); err != nil {
+			b.Fatal(err)
+		}
+		dec.Close()
+	}
+}
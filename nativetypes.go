@@ -24,6 +24,7 @@ import (
 	"io"
 
 	bin "github.com/gagliardetto/binary"
+	"github.com/klauspost/compress/zstd"
 	"github.com/mostynb/zstdpool-freelist"
 	"github.com/mr-tron/base58"
 )
@@ -229,7 +230,22 @@ func (t Data) MarshalJSON() ([]byte, error) {
 		})
 }
 
-var zstdDecoderPool = zstdpool.NewDecoderPool()
+// zstdDecoderPool reuses the zstd decoders across the decoding of "base64+zstd" data,
+// as creating a decoder for each account is expensive when scanning many accounts.
+var zstdDecoderPool = newZstdDecoderPool()
+
+func newZstdDecoderPool(opts ...zstd.DOption) *zstdpool.DecoderPool {
+	pool := zstdpool.NewDecoderPool(opts...)
+	return &pool
+}
+
+// SetZstdDecoderOptions replaces the pool of decoders used for "base64+zstd" data
+// with one whose decoders are created with the provided options
+// (e.g. zstd.WithDecoderConcurrency or zstd.WithDecoderMaxMemory).
+// It is not safe to call while data is being decoded; call it at startup.
+func SetZstdDecoderOptions(opts ...zstd.DOption) {
+	zstdDecoderPool = newZstdDecoderPool(opts...)
+}
 
 func (t *Data) UnmarshalJSON(data []byte) (err error) {
 	var in []string
@@ -0,0 +1,220 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package associatedtokenaccount
+
+import (
+	"errors"
+	"fmt"
+
+	bin "github.com/gagliardetto/binary"
+	solana "github.com/gagliardetto/solana-go"
+	format "github.com/gagliardetto/solana-go/text/format"
+	treeout "github.com/gagliardetto/treeout"
+)
+
+// CreateIdempotent creates an associated token account for the given wallet address and token mint,
+// like Create, but succeeds if the account already exists (instead of failing).
+type CreateIdempotent struct {
+	Payer          solana.PublicKey `bin:"-" borsh_skip:"true"`
+	Wallet         solana.PublicKey `bin:"-" borsh_skip:"true"`
+	Mint           solana.PublicKey `bin:"-" borsh_skip:"true"`
+	TokenProgramID solana.PublicKey `bin:"-" borsh_skip:"true"`
+
+	// [0] = [WRITE, SIGNER] Payer
+	// ··········· Funding account
+	//
+	// [1] = [WRITE] AssociatedTokenAccount
+	// ··········· Associated token account address to be created
+	//
+	// [2] = [] Wallet
+	// ··········· Wallet address for the new associated token account
+	//
+	// [3] = [] TokenMint
+	// ··········· The token mint for the new associated token account
+	//
+	// [4] = [] SystemProgram
+	// ··········· System program ID
+	//
+	// [5] = [] TokenProgram
+	// ··········· SPL token program ID (token or Token-2022) that owns the mint
+	//
+	// [6] = [] SysVarRent
+	// ··········· SysVarRentPubkey
+	solana.AccountMetaSlice `bin:"-" borsh_skip:"true"`
+}
+
+// NewCreateIdempotentInstructionBuilder creates a new `CreateIdempotent` instruction builder.
+func NewCreateIdempotentInstructionBuilder() *CreateIdempotent {
+	nd := &CreateIdempotent{
+		TokenProgramID: solana.TokenProgramID,
+	}
+	return nd
+}
+
+func (inst *CreateIdempotent) SetPayer(payer solana.PublicKey) *CreateIdempotent {
+	inst.Payer = payer
+	return inst
+}
+
+func (inst *CreateIdempotent) SetWallet(wallet solana.PublicKey) *CreateIdempotent {
+	inst.Wallet = wallet
+	return inst
+}
+
+func (inst *CreateIdempotent) SetMint(mint solana.PublicKey) *CreateIdempotent {
+	inst.Mint = mint
+	return inst
+}
+
+// SetTokenProgramID sets the token program that owns the mint
+// (defaults to solana.TokenProgramID); use solana.Token2022ProgramID
+// for Token-2022 mints.
+func (inst *CreateIdempotent) SetTokenProgramID(programID solana.PublicKey) *CreateIdempotent {
+	inst.TokenProgramID = programID
+	return inst
+}
+
+func (inst CreateIdempotent) Build() *Instruction {
+
+	// Find the associatedTokenAddress;
+	associatedTokenAddress, _, _ := solana.FindAssociatedTokenAddressWithProgram(
+		inst.Wallet,
+		inst.Mint,
+		inst.TokenProgramID,
+	)
+
+	keys := []*solana.AccountMeta{
+		{
+			PublicKey:  inst.Payer,
+			IsSigner:   true,
+			IsWritable: true,
+		},
+		{
+			PublicKey:  associatedTokenAddress,
+			IsSigner:   false,
+			IsWritable: true,
+		},
+		{
+			PublicKey:  inst.Wallet,
+			IsSigner:   false,
+			IsWritable: false,
+		},
+		{
+			PublicKey:  inst.Mint,
+			IsSigner:   false,
+			IsWritable: false,
+		},
+		{
+			PublicKey:  solana.SystemProgramID,
+			IsSigner:   false,
+			IsWritable: false,
+		},
+		{
+			PublicKey:  inst.TokenProgramID,
+			IsSigner:   false,
+			IsWritable: false,
+		},
+		{
+			PublicKey:  solana.SysVarRentPubkey,
+			IsSigner:   false,
+			IsWritable: false,
+		},
+	}
+
+	inst.AccountMetaSlice = keys
+
+	return &Instruction{BaseVariant: bin.BaseVariant{
+		Impl:   inst,
+		TypeID: bin.TypeIDFromUint8(Instruction_CreateIdempotent),
+	}}
+}
+
+// ValidateAndBuild validates the instruction accounts.
+// If there is a validation error, return the error.
+// Otherwise, build and return the instruction.
+func (inst CreateIdempotent) ValidateAndBuild() (*Instruction, error) {
+	if err := inst.Validate(); err != nil {
+		return nil, err
+	}
+	return inst.Build(), nil
+}
+
+func (inst *CreateIdempotent) Validate() error {
+	if inst.Payer.IsZero() {
+		return errors.New("Payer not set")
+	}
+	if inst.Wallet.IsZero() {
+		return errors.New("Wallet not set")
+	}
+	if inst.Mint.IsZero() {
+		return errors.New("Mint not set")
+	}
+	if inst.TokenProgramID.IsZero() {
+		return errors.New("TokenProgramID not set")
+	}
+	_, _, err := solana.FindAssociatedTokenAddressWithProgram(
+		inst.Wallet,
+		inst.Mint,
+		inst.TokenProgramID,
+	)
+	if err != nil {
+		return fmt.Errorf("error while FindAssociatedTokenAddress: %w", err)
+	}
+	return nil
+}
+
+func (inst *CreateIdempotent) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, ProgramID)).
+		//
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("CreateIdempotent")).
+				//
+				ParentFunc(func(instructionBranch treeout.Branches) {
+
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=0]").ParentFunc(func(paramsBranch treeout.Branches) {})
+
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=7").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(format.Meta("                 payer", inst.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(format.Meta("associatedTokenAddress", inst.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(format.Meta("                wallet", inst.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(format.Meta("             tokenMint", inst.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(format.Meta("         systemProgram", inst.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(format.Meta("          tokenProgram", inst.AccountMetaSlice.Get(5)))
+						accountsBranch.Child(format.Meta("            sysVarRent", inst.AccountMetaSlice.Get(6)))
+					})
+				})
+		})
+}
+
+func (inst CreateIdempotent) MarshalWithEncoder(encoder *bin.Encoder) error {
+	return nil
+}
+
+func (inst *CreateIdempotent) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	return nil
+}
+
+func NewCreateIdempotentInstruction(
+	payer solana.PublicKey,
+	walletAddress solana.PublicKey,
+	splTokenMintAddress solana.PublicKey,
+) *CreateIdempotent {
+	return NewCreateIdempotentInstructionBuilder().
+		SetPayer(payer).
+		SetWallet(walletAddress).
+		SetMint(splTokenMintAddress)
+}
@@ -0,0 +1,94 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package associatedtokenaccount
+
+import (
+	"testing"
+
+	solana "github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateIdempotent_Encode(t *testing.T) {
+	payer := solana.MustPublicKeyFromBase58("7xLk17EQQ5KLDLDe44wCmupJKJjTGd8hs3eSVVhCx932")
+	wallet := solana.MustPublicKeyFromBase58("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM")
+	mint := solana.MustPublicKeyFromBase58("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+
+	inst, err := NewCreateIdempotentInstruction(payer, wallet, mint).ValidateAndBuild()
+	require.NoError(t, err)
+
+	data, err := inst.Data()
+	require.NoError(t, err)
+	require.Equal(t, []byte{1}, data)
+
+	ata, _, err := solana.FindAssociatedTokenAddress(wallet, mint)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		[]*solana.AccountMeta{
+			solana.Meta(payer).WRITE().SIGNER(),
+			solana.Meta(ata).WRITE(),
+			solana.Meta(wallet),
+			solana.Meta(mint),
+			solana.Meta(solana.SystemProgramID),
+			solana.Meta(solana.TokenProgramID),
+			solana.Meta(solana.SysVarRentPubkey),
+		},
+		inst.Accounts(),
+	)
+
+	decoded, err := DecodeInstruction(inst.Accounts(), data)
+	require.NoError(t, err)
+	require.IsType(t, &CreateIdempotent{}, decoded.Impl)
+}
+
+func TestCreateIdempotent_Token2022(t *testing.T) {
+	payer := solana.MustPublicKeyFromBase58("7xLk17EQQ5KLDLDe44wCmupJKJjTGd8hs3eSVVhCx932")
+	wallet := solana.MustPublicKeyFromBase58("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM")
+	pyusd := solana.MustPublicKeyFromBase58("2b1kV6DkPAnxd5ixfnxCpjxmKwqjjaYmCZfHsFu24GXo") // Token-2022 mint
+
+	inst, err := NewCreateIdempotentInstruction(payer, wallet, pyusd).
+		SetTokenProgramID(solana.Token2022ProgramID).
+		ValidateAndBuild()
+	require.NoError(t, err)
+
+	accounts := inst.Accounts()
+	require.Len(t, accounts, 7)
+	require.Equal(t, solana.Meta(solana.MustPublicKeyFromBase58("897krAvWH3RbymaCYE3o9emopUwocieHuKTUk9nySpq6")).WRITE(), accounts[1])
+	require.Equal(t, solana.Meta(solana.Token2022ProgramID), accounts[5])
+
+	_, err = NewCreateIdempotentInstruction(payer, wallet, pyusd).
+		SetTokenProgramID(solana.PublicKey{}).
+		ValidateAndBuild()
+	require.EqualError(t, err, "TokenProgramID not set")
+}
+
+func TestCreate_EncodeLegacy(t *testing.T) {
+	payer := solana.MustPublicKeyFromBase58("7xLk17EQQ5KLDLDe44wCmupJKJjTGd8hs3eSVVhCx932")
+	wallet := solana.MustPublicKeyFromBase58("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM")
+	mint := solana.MustPublicKeyFromBase58("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+
+	inst, err := NewCreateInstruction(payer, wallet, mint).ValidateAndBuild()
+	require.NoError(t, err)
+
+	// Create keeps being encoded with empty data.
+	data, err := inst.Data()
+	require.NoError(t, err)
+	require.Empty(t, data)
+
+	decoded, err := DecodeInstruction(inst.Accounts(), data)
+	require.NoError(t, err)
+	require.IsType(t, &Create{}, decoded.Impl)
+}
@@ -0,0 +1,55 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package associatedtokenaccount
+
+import (
+	"testing"
+
+	solana "github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreate_DerivesAssociatedTokenAddress(t *testing.T) {
+	payer := solana.MustPublicKeyFromBase58("7xLk17EQQ5KLDLDe44wCmupJKJjTGd8hs3eSVVhCx932")
+	wallet := solana.MustPublicKeyFromBase58("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM")
+	usdc := solana.MustPublicKeyFromBase58("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+
+	inst, err := NewCreateInstruction(payer, wallet, usdc).ValidateAndBuild()
+	require.NoError(t, err)
+
+	accounts := inst.Accounts()
+	require.Equal(t,
+		solana.Meta(solana.MustPublicKeyFromBase58("FGETo8T8wMcN2wCjav8VK6eh3dLk63evNDPxzLSJra8B")).WRITE(),
+		accounts[1],
+	)
+	require.Equal(t, solana.Meta(payer).WRITE().SIGNER(), accounts[0])
+	require.Equal(t, solana.Meta(wallet), accounts[2])
+	require.Equal(t, solana.Meta(usdc), accounts[3])
+	require.Equal(t, solana.Meta(solana.SystemProgramID), accounts[4])
+	require.Equal(t, solana.Meta(solana.TokenProgramID), accounts[5])
+}
+
+func TestCreate_Validate(t *testing.T) {
+	payer := solana.MustPublicKeyFromBase58("7xLk17EQQ5KLDLDe44wCmupJKJjTGd8hs3eSVVhCx932")
+	wallet := solana.MustPublicKeyFromBase58("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM")
+	mint := solana.MustPublicKeyFromBase58("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+
+	_, err := NewCreateInstruction(solana.PublicKey{}, wallet, mint).ValidateAndBuild()
+	require.EqualError(t, err, "Payer not set")
+	_, err = NewCreateInstruction(payer, solana.PublicKey{}, mint).ValidateAndBuild()
+	require.EqualError(t, err, "Wallet not set")
+	_, err = NewCreateInstruction(payer, wallet, solana.PublicKey{}).ValidateAndBuild()
+	require.EqualError(t, err, "Mint not set")
+}
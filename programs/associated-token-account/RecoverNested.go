@@ -0,0 +1,249 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package associatedtokenaccount
+
+import (
+	"errors"
+	"fmt"
+
+	bin "github.com/gagliardetto/binary"
+	solana "github.com/gagliardetto/solana-go"
+	format "github.com/gagliardetto/solana-go/text/format"
+	treeout "github.com/gagliardetto/treeout"
+)
+
+// RecoverNested transfers the tokens from a nested associated token account
+// (an associated token account owned by another associated token account)
+// to the wallet's associated token account for the nested mint, and closes
+// the nested account, sending its lamports to the wallet.
+type RecoverNested struct {
+	Wallet         solana.PublicKey `bin:"-" borsh_skip:"true"`
+	OwnerMint      solana.PublicKey `bin:"-" borsh_skip:"true"`
+	NestedMint     solana.PublicKey `bin:"-" borsh_skip:"true"`
+	TokenProgramID solana.PublicKey `bin:"-" borsh_skip:"true"`
+
+	// [0] = [WRITE] NestedAssociatedTokenAccount
+	// ··········· Nested associated token account, owned by the owner associated token account
+	//
+	// [1] = [] NestedTokenMint
+	// ··········· Token mint for the nested associated token account
+	//
+	// [2] = [WRITE] DestinationAssociatedTokenAccount
+	// ··········· Wallet's associated token account for the nested token mint
+	//
+	// [3] = [] OwnerAssociatedTokenAccount
+	// ··········· Owner associated token account address, owned by the wallet
+	//
+	// [4] = [] OwnerTokenMint
+	// ··········· Token mint for the owner associated token account
+	//
+	// [5] = [WRITE, SIGNER] Wallet
+	// ··········· Wallet address for the owner associated token account
+	//
+	// [6] = [] TokenProgram
+	// ··········· SPL token program ID
+	solana.AccountMetaSlice `bin:"-" borsh_skip:"true"`
+}
+
+// NewRecoverNestedInstructionBuilder creates a new `RecoverNested` instruction builder.
+func NewRecoverNestedInstructionBuilder() *RecoverNested {
+	nd := &RecoverNested{
+		TokenProgramID: solana.TokenProgramID,
+	}
+	return nd
+}
+
+func (inst *RecoverNested) SetWallet(wallet solana.PublicKey) *RecoverNested {
+	inst.Wallet = wallet
+	return inst
+}
+
+func (inst *RecoverNested) SetOwnerMint(mint solana.PublicKey) *RecoverNested {
+	inst.OwnerMint = mint
+	return inst
+}
+
+func (inst *RecoverNested) SetNestedMint(mint solana.PublicKey) *RecoverNested {
+	inst.NestedMint = mint
+	return inst
+}
+
+// SetTokenProgramID sets the token program that owns both mints
+// (defaults to solana.TokenProgramID).
+func (inst *RecoverNested) SetTokenProgramID(programID solana.PublicKey) *RecoverNested {
+	inst.TokenProgramID = programID
+	return inst
+}
+
+func (inst RecoverNested) Build() *Instruction {
+
+	// Find the associated token addresses;
+	ownerAssociatedTokenAddress, _, _ := solana.FindAssociatedTokenAddressWithProgram(
+		inst.Wallet,
+		inst.OwnerMint,
+		inst.TokenProgramID,
+	)
+	nestedAssociatedTokenAddress, _, _ := solana.FindAssociatedTokenAddressWithProgram(
+		ownerAssociatedTokenAddress,
+		inst.NestedMint,
+		inst.TokenProgramID,
+	)
+	destinationAssociatedTokenAddress, _, _ := solana.FindAssociatedTokenAddressWithProgram(
+		inst.Wallet,
+		inst.NestedMint,
+		inst.TokenProgramID,
+	)
+
+	keys := []*solana.AccountMeta{
+		{
+			PublicKey:  nestedAssociatedTokenAddress,
+			IsSigner:   false,
+			IsWritable: true,
+		},
+		{
+			PublicKey:  inst.NestedMint,
+			IsSigner:   false,
+			IsWritable: false,
+		},
+		{
+			PublicKey:  destinationAssociatedTokenAddress,
+			IsSigner:   false,
+			IsWritable: true,
+		},
+		{
+			PublicKey:  ownerAssociatedTokenAddress,
+			IsSigner:   false,
+			IsWritable: false,
+		},
+		{
+			PublicKey:  inst.OwnerMint,
+			IsSigner:   false,
+			IsWritable: false,
+		},
+		{
+			PublicKey:  inst.Wallet,
+			IsSigner:   true,
+			IsWritable: true,
+		},
+		{
+			PublicKey:  inst.TokenProgramID,
+			IsSigner:   false,
+			IsWritable: false,
+		},
+	}
+
+	inst.AccountMetaSlice = keys
+
+	return &Instruction{BaseVariant: bin.BaseVariant{
+		Impl:   inst,
+		TypeID: bin.TypeIDFromUint8(Instruction_RecoverNested),
+	}}
+}
+
+// ValidateAndBuild validates the instruction accounts.
+// If there is a validation error, return the error.
+// Otherwise, build and return the instruction.
+func (inst RecoverNested) ValidateAndBuild() (*Instruction, error) {
+	if err := inst.Validate(); err != nil {
+		return nil, err
+	}
+	return inst.Build(), nil
+}
+
+func (inst *RecoverNested) Validate() error {
+	if inst.Wallet.IsZero() {
+		return errors.New("Wallet not set")
+	}
+	if inst.OwnerMint.IsZero() {
+		return errors.New("OwnerMint not set")
+	}
+	if inst.NestedMint.IsZero() {
+		return errors.New("NestedMint not set")
+	}
+	if inst.TokenProgramID.IsZero() {
+		return errors.New("TokenProgramID not set")
+	}
+	ownerAssociatedTokenAddress, _, err := solana.FindAssociatedTokenAddressWithProgram(
+		inst.Wallet,
+		inst.OwnerMint,
+		inst.TokenProgramID,
+	)
+	if err != nil {
+		return fmt.Errorf("error while FindAssociatedTokenAddress for the owner: %w", err)
+	}
+	_, _, err = solana.FindAssociatedTokenAddressWithProgram(
+		ownerAssociatedTokenAddress,
+		inst.NestedMint,
+		inst.TokenProgramID,
+	)
+	if err != nil {
+		return fmt.Errorf("error while FindAssociatedTokenAddress for the nested account: %w", err)
+	}
+	_, _, err = solana.FindAssociatedTokenAddressWithProgram(
+		inst.Wallet,
+		inst.NestedMint,
+		inst.TokenProgramID,
+	)
+	if err != nil {
+		return fmt.Errorf("error while FindAssociatedTokenAddress for the destination: %w", err)
+	}
+	return nil
+}
+
+func (inst *RecoverNested) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, ProgramID)).
+		//
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("RecoverNested")).
+				//
+				ParentFunc(func(instructionBranch treeout.Branches) {
+
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=0]").ParentFunc(func(paramsBranch treeout.Branches) {})
+
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=7]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(format.Meta("      nestedAssociatedTokenAddress", inst.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(format.Meta("                   nestedTokenMint", inst.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(format.Meta(" destinationAssociatedTokenAddress", inst.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(format.Meta("       ownerAssociatedTokenAddress", inst.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(format.Meta("                    ownerTokenMint", inst.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(format.Meta("                            wallet", inst.AccountMetaSlice.Get(5)))
+						accountsBranch.Child(format.Meta("                      tokenProgram", inst.AccountMetaSlice.Get(6)))
+					})
+				})
+		})
+}
+
+func (inst RecoverNested) MarshalWithEncoder(encoder *bin.Encoder) error {
+	return nil
+}
+
+func (inst *RecoverNested) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	return nil
+}
+
+// NewRecoverNestedInstruction declares a new RecoverNested instruction,
+// recovering the nestedMint tokens held by the wallet's ownerMint associated token account.
+func NewRecoverNestedInstruction(
+	walletAddress solana.PublicKey,
+	ownerMintAddress solana.PublicKey,
+	nestedMintAddress solana.PublicKey,
+) *RecoverNested {
+	return NewRecoverNestedInstructionBuilder().
+		SetWallet(walletAddress).
+		SetOwnerMint(ownerMintAddress).
+		SetNestedMint(nestedMintAddress)
+}
@@ -0,0 +1,73 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package associatedtokenaccount
+
+import (
+	"testing"
+
+	solana "github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecoverNested_Encode(t *testing.T) {
+	wallet := solana.MustPublicKeyFromBase58("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM")
+	ownerMint := solana.MustPublicKeyFromBase58("So11111111111111111111111111111111111111112")
+	nestedMint := solana.MustPublicKeyFromBase58("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+
+	inst, err := NewRecoverNestedInstruction(wallet, ownerMint, nestedMint).ValidateAndBuild()
+	require.NoError(t, err)
+
+	data, err := inst.Data()
+	require.NoError(t, err)
+	require.Equal(t, []byte{2}, data)
+
+	ownerATA, _, err := solana.FindAssociatedTokenAddress(wallet, ownerMint)
+	require.NoError(t, err)
+	nestedATA, _, err := solana.FindAssociatedTokenAddress(ownerATA, nestedMint)
+	require.NoError(t, err)
+	destinationATA, _, err := solana.FindAssociatedTokenAddress(wallet, nestedMint)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		[]*solana.AccountMeta{
+			solana.Meta(nestedATA).WRITE(),
+			solana.Meta(nestedMint),
+			solana.Meta(destinationATA).WRITE(),
+			solana.Meta(ownerATA),
+			solana.Meta(ownerMint),
+			solana.Meta(wallet).WRITE().SIGNER(),
+			solana.Meta(solana.TokenProgramID),
+		},
+		inst.Accounts(),
+	)
+
+	decoded, err := DecodeInstruction(inst.Accounts(), data)
+	require.NoError(t, err)
+	require.IsType(t, &RecoverNested{}, decoded.Impl)
+	require.Equal(t, "RecoverNested", InstructionIDToName(decoded.TypeID.Uint8()))
+
+	// Token-2022 mints derive their associated token accounts with their own program.
+	inst, err = NewRecoverNestedInstruction(wallet, ownerMint, nestedMint).
+		SetTokenProgramID(solana.Token2022ProgramID).
+		ValidateAndBuild()
+	require.NoError(t, err)
+	ownerATA, _, err = solana.FindAssociatedTokenAddressWithProgram(wallet, ownerMint, solana.Token2022ProgramID)
+	require.NoError(t, err)
+	require.Equal(t, ownerATA, inst.Accounts()[3].PublicKey)
+	require.Equal(t, solana.Token2022ProgramID, inst.Accounts()[6].PublicKey)
+
+	_, err = NewRecoverNestedInstruction(wallet, ownerMint, solana.PublicKey{}).ValidateAndBuild()
+	require.EqualError(t, err, "NestedMint not set")
+}
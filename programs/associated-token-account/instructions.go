@@ -15,6 +15,7 @@
 package associatedtokenaccount
 
 import (
+	"bytes"
 	"fmt"
 
 	spew "github.com/davecgh/go-spew/spew"
@@ -37,6 +38,33 @@ func init() {
 	solana.RegisterInstructionDecoder(ProgramID, registryDecodeInstruction)
 }
 
+const (
+	// Create an associated token account;
+	// NOTE: legacy Create instructions have no data at all.
+	Instruction_Create uint8 = iota
+
+	// Create an associated token account if it doesn't already exist
+	Instruction_CreateIdempotent
+
+	// Transfer the tokens of a nested associated token account to the wallet's
+	// associated token account, and close the nested account.
+	Instruction_RecoverNested
+)
+
+// InstructionIDToName returns the name of the instruction given its ID.
+func InstructionIDToName(id uint8) string {
+	switch id {
+	case Instruction_Create:
+		return "Create"
+	case Instruction_CreateIdempotent:
+		return "CreateIdempotent"
+	case Instruction_RecoverNested:
+		return "RecoverNested"
+	default:
+		return ""
+	}
+}
+
 type Instruction struct {
 	bin.BaseVariant
 }
@@ -50,11 +78,17 @@ func (inst *Instruction) EncodeToTree(parent treeout.Branches) {
 }
 
 var InstructionImplDef = bin.NewVariantDefinition(
-	bin.NoTypeIDEncoding, // NOTE: the associated-token-account program has no ID encoding.
+	bin.Uint8TypeIDEncoding,
 	[]bin.VariantType{
 		{
 			"Create", (*Create)(nil),
 		},
+		{
+			"CreateIdempotent", (*CreateIdempotent)(nil),
+		},
+		{
+			"RecoverNested", (*RecoverNested)(nil),
+		},
 	},
 )
 
@@ -67,7 +101,11 @@ func (inst *Instruction) Accounts() (out []*solana.AccountMeta) {
 }
 
 func (inst *Instruction) Data() ([]byte, error) {
-	return []byte{}, nil
+	buf := new(bytes.Buffer)
+	if err := bin.NewBinEncoder(buf).Encode(inst); err != nil {
+		return nil, fmt.Errorf("unable to encode instruction: %w", err)
+	}
+	return buf.Bytes(), nil
 }
 
 func (inst *Instruction) TextEncode(encoder *text.Encoder, option *text.Option) error {
@@ -75,10 +113,26 @@ func (inst *Instruction) TextEncode(encoder *text.Encoder, option *text.Option)
 }
 
 func (inst *Instruction) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	if decoder.Remaining() == 0 {
+		// Legacy Create instruction, with no data.
+		inst.BaseVariant = bin.BaseVariant{
+			TypeID: bin.TypeIDFromUint8(Instruction_Create),
+			Impl:   new(Create),
+		}
+		return nil
+	}
 	return inst.BaseVariant.UnmarshalBinaryVariant(decoder, InstructionImplDef)
 }
 
 func (inst Instruction) MarshalWithEncoder(encoder *bin.Encoder) error {
+	if inst.TypeID.Uint8() == Instruction_Create {
+		// Keep encoding Create with no data, as the program always accepted it.
+		return encoder.Encode(inst.Impl)
+	}
+	err := encoder.WriteUint8(inst.TypeID.Uint8())
+	if err != nil {
+		return fmt.Errorf("unable to write variant type: %w", err)
+	}
 	return encoder.Encode(inst.Impl)
 }
 
@@ -0,0 +1,129 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bpfloader
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_format "github.com/gagliardetto/solana-go/text/format"
+	ag_treeout "github.com/gagliardetto/treeout"
+)
+
+// Initialize a Buffer account.
+type InitializeBuffer struct {
+
+	// [0] = [WRITE] BufferAccount
+	// ··········· Source account to initialize
+	//
+	// [1] = [] AuthorityAccount
+	// ··········· Buffer authority, optional; if omitted the buffer will be immutable
+	ag_solanago.AccountMetaSlice `bin:"-" borsh_skip:"true"`
+}
+
+// NewInitializeBufferInstructionBuilder creates a new `InitializeBuffer` instruction builder.
+func NewInitializeBufferInstructionBuilder() *InitializeBuffer {
+	nd := &InitializeBuffer{
+		AccountMetaSlice: make(ag_solanago.AccountMetaSlice, 2),
+	}
+	return nd
+}
+
+// Source account to initialize
+func (inst *InitializeBuffer) SetBufferAccount(bufferAccount ag_solanago.PublicKey) *InitializeBuffer {
+	inst.AccountMetaSlice[0] = ag_solanago.Meta(bufferAccount).WRITE()
+	return inst
+}
+
+func (inst *InitializeBuffer) GetBufferAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[0]
+}
+
+// Buffer authority, optional; if omitted the buffer will be immutable
+func (inst *InitializeBuffer) SetAuthorityAccount(authorityAccount ag_solanago.PublicKey) *InitializeBuffer {
+	inst.AccountMetaSlice[1] = ag_solanago.Meta(authorityAccount)
+	return inst
+}
+
+func (inst *InitializeBuffer) GetAuthorityAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice.Get(1)
+}
+
+func (inst InitializeBuffer) Build() *Instruction {
+	return &Instruction{BaseVariant: ag_binary.BaseVariant{
+		Impl:   inst,
+		TypeID: ag_binary.TypeIDFromUint32(Instruction_InitializeBuffer, binary.LittleEndian),
+	}}
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (inst InitializeBuffer) ValidateAndBuild() (*Instruction, error) {
+	if err := inst.Validate(); err != nil {
+		return nil, err
+	}
+	return inst.Build(), nil
+}
+
+func (inst *InitializeBuffer) Validate() error {
+	// Check whether all (required) accounts are set:
+	for accIndex, acc := range inst.AccountMetaSlice[:1] {
+		if acc == nil {
+			return fmt.Errorf("ins.AccountMetaSlice[%v] is not set", accIndex)
+		}
+	}
+	return nil
+}
+
+func (inst *InitializeBuffer) EncodeToTree(parent ag_treeout.Branches) {
+	parent.Child(ag_format.Program(ProgramName, ProgramID)).
+		//
+		ParentFunc(func(programBranch ag_treeout.Branches) {
+			programBranch.Child(ag_format.Instruction("InitializeBuffer")).
+				//
+				ParentFunc(func(instructionBranch ag_treeout.Branches) {
+
+					// Parameters of the instruction:
+					instructionBranch.Child("Params").ParentFunc(func(paramsBranch ag_treeout.Branches) {})
+
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts").ParentFunc(func(accountsBranch ag_treeout.Branches) {
+						accountsBranch.Child(ag_format.Meta("   Buffer", inst.AccountMetaSlice[0]))
+						accountsBranch.Child(ag_format.MetaIfSetByIndex("Authority", inst.AccountMetaSlice, 1))
+					})
+				})
+		})
+}
+
+func (inst InitializeBuffer) MarshalWithEncoder(encoder *ag_binary.Encoder) error {
+	return nil
+}
+
+func (inst *InitializeBuffer) UnmarshalWithDecoder(decoder *ag_binary.Decoder) error {
+	return nil
+}
+
+// NewInitializeBufferInstruction declares a new InitializeBuffer instruction with the provided parameters and accounts.
+func NewInitializeBufferInstruction(
+	// Accounts:
+	bufferAccount ag_solanago.PublicKey,
+	authorityAccount ag_solanago.PublicKey) *InitializeBuffer {
+	return NewInitializeBufferInstructionBuilder().
+		SetBufferAccount(bufferAccount).
+		SetAuthorityAccount(authorityAccount)
+}
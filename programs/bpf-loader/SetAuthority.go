@@ -0,0 +1,165 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bpfloader
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_format "github.com/gagliardetto/solana-go/text/format"
+	ag_treeout "github.com/gagliardetto/treeout"
+)
+
+// Set a new authority that is allowed to write the buffer or upgrade the program.
+type SetAuthority struct {
+
+	// [0] = [WRITE] BufferOrProgramDataAccount
+	// ··········· The Buffer or ProgramData account to change the authority of
+	//
+	// [1] = [SIGNER] CurrentAuthorityAccount
+	// ··········· The current authority
+	//
+	// [2] = [] NewAuthorityAccount
+	// ··········· The new authority, optional; if omitted the program will not be upgradeable
+	ag_solanago.AccountMetaSlice `bin:"-" borsh_skip:"true"`
+}
+
+// NewSetAuthorityInstructionBuilder creates a new `SetAuthority` instruction builder.
+func NewSetAuthorityInstructionBuilder() *SetAuthority {
+	nd := &SetAuthority{
+		AccountMetaSlice: make(ag_solanago.AccountMetaSlice, 3),
+	}
+	return nd
+}
+
+// The Buffer or ProgramData account to change the authority of
+func (inst *SetAuthority) SetBufferOrProgramDataAccount(bufferOrProgramDataAccount ag_solanago.PublicKey) *SetAuthority {
+	inst.AccountMetaSlice[0] = ag_solanago.Meta(bufferOrProgramDataAccount).WRITE()
+	return inst
+}
+
+func (inst *SetAuthority) GetBufferOrProgramDataAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[0]
+}
+
+// The current authority
+func (inst *SetAuthority) SetCurrentAuthorityAccount(currentAuthorityAccount ag_solanago.PublicKey) *SetAuthority {
+	inst.AccountMetaSlice[1] = ag_solanago.Meta(currentAuthorityAccount).SIGNER()
+	return inst
+}
+
+func (inst *SetAuthority) GetCurrentAuthorityAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[1]
+}
+
+// The new authority, optional; if omitted the program will not be upgradeable
+func (inst *SetAuthority) SetNewAuthorityAccount(newAuthorityAccount ag_solanago.PublicKey) *SetAuthority {
+	inst.AccountMetaSlice[2] = ag_solanago.Meta(newAuthorityAccount)
+	return inst
+}
+
+func (inst *SetAuthority) GetNewAuthorityAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice.Get(2)
+}
+
+func (inst SetAuthority) Build() *Instruction {
+	return &Instruction{BaseVariant: ag_binary.BaseVariant{
+		Impl:   inst,
+		TypeID: ag_binary.TypeIDFromUint32(Instruction_SetAuthority, binary.LittleEndian),
+	}}
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (inst SetAuthority) ValidateAndBuild() (*Instruction, error) {
+	if err := inst.Validate(); err != nil {
+		return nil, err
+	}
+	return inst.Build(), nil
+}
+
+func (inst *SetAuthority) Validate() error {
+	// Check whether all (required) accounts are set:
+	for accIndex, acc := range inst.AccountMetaSlice[:2] {
+		if acc == nil {
+			return fmt.Errorf("ins.AccountMetaSlice[%v] is not set", accIndex)
+		}
+	}
+	if !inst.AccountMetaSlice[1].IsSigner {
+		return errors.New("CurrentAuthorityAccount must be a signer")
+	}
+	return nil
+}
+
+func (inst *SetAuthority) EncodeToTree(parent ag_treeout.Branches) {
+	parent.Child(ag_format.Program(ProgramName, ProgramID)).
+		//
+		ParentFunc(func(programBranch ag_treeout.Branches) {
+			programBranch.Child(ag_format.Instruction("SetAuthority")).
+				//
+				ParentFunc(func(instructionBranch ag_treeout.Branches) {
+
+					// Parameters of the instruction:
+					instructionBranch.Child("Params").ParentFunc(func(paramsBranch ag_treeout.Branches) {})
+
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts").ParentFunc(func(accountsBranch ag_treeout.Branches) {
+						accountsBranch.Child(ag_format.Meta("BufferOrProgramData", inst.AccountMetaSlice[0]))
+						accountsBranch.Child(ag_format.Meta("   CurrentAuthority", inst.AccountMetaSlice[1]))
+						accountsBranch.Child(ag_format.MetaIfSetByIndex("       NewAuthority", inst.AccountMetaSlice, 2))
+					})
+				})
+		})
+}
+
+func (inst SetAuthority) MarshalWithEncoder(encoder *ag_binary.Encoder) error {
+	return nil
+}
+
+func (inst *SetAuthority) UnmarshalWithDecoder(decoder *ag_binary.Decoder) error {
+	return nil
+}
+
+// NewSetAuthorityInstruction declares a new SetAuthority instruction with the provided parameters and accounts.
+func NewSetAuthorityInstruction(
+	// Accounts:
+	bufferOrProgramDataAccount ag_solanago.PublicKey,
+	currentAuthorityAccount ag_solanago.PublicKey,
+	newAuthorityAccount ag_solanago.PublicKey) *SetAuthority {
+	return NewSetAuthorityInstructionBuilder().
+		SetBufferOrProgramDataAccount(bufferOrProgramDataAccount).
+		SetCurrentAuthorityAccount(currentAuthorityAccount).
+		SetNewAuthorityAccount(newAuthorityAccount)
+}
+
+// NewSetUpgradeAuthorityInstruction declares a new SetAuthority instruction
+// that changes the upgrade authority of a program, given its ProgramData account;
+// if newAuthority is nil, the program becomes immutable.
+func NewSetUpgradeAuthorityInstruction(
+	programData ag_solanago.PublicKey,
+	currentAuthority ag_solanago.PublicKey,
+	newAuthority *ag_solanago.PublicKey) *SetAuthority {
+	inst := NewSetAuthorityInstructionBuilder().
+		SetBufferOrProgramDataAccount(programData).
+		SetCurrentAuthorityAccount(currentAuthority)
+	if newAuthority != nil {
+		inst.SetNewAuthorityAccount(*newAuthority)
+	}
+	return inst
+}
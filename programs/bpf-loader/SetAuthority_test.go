@@ -0,0 +1,96 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bpfloader
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_require "github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode_SetAuthority(t *testing.T) {
+	fu := ag_gofuzz.New().NilChance(0)
+	for i := 0; i < 1; i++ {
+		t.Run("SetAuthority"+strconv.Itoa(i), func(t *testing.T) {
+			{
+				params := new(SetAuthority)
+				fu.Fuzz(params)
+				params.AccountMetaSlice = nil
+				buf := new(bytes.Buffer)
+				err := encodeT(*params, buf)
+				ag_require.NoError(t, err)
+				//
+				got := new(SetAuthority)
+				err = decodeT(got, buf.Bytes())
+				got.AccountMetaSlice = nil
+				ag_require.NoError(t, err)
+				ag_require.Equal(t, params, got)
+			}
+		})
+	}
+}
+
+func TestNewSetUpgradeAuthorityInstruction(t *testing.T) {
+	programData := ag_solanago.NewWallet().PublicKey()
+	currentAuthority := ag_solanago.NewWallet().PublicKey()
+	newAuthority := ag_solanago.NewWallet().PublicKey()
+
+	{
+		inst, err := NewSetUpgradeAuthorityInstruction(programData, currentAuthority, &newAuthority).ValidateAndBuild()
+		ag_require.NoError(t, err)
+		ag_require.Equal(t, ag_solanago.BPFLoaderUpgradeableProgramID, inst.ProgramID())
+
+		data, err := inst.Data()
+		ag_require.NoError(t, err)
+		ag_require.Equal(t, []byte{4, 0, 0, 0}, data)
+
+		ag_require.Equal(t,
+			[]*ag_solanago.AccountMeta{
+				ag_solanago.Meta(programData).WRITE(),
+				ag_solanago.Meta(currentAuthority).SIGNER(),
+				ag_solanago.Meta(newAuthority),
+			},
+			inst.Accounts(),
+		)
+	}
+	{
+		// Without a new authority, the program becomes immutable:
+		inst, err := NewSetUpgradeAuthorityInstruction(programData, currentAuthority, nil).ValidateAndBuild()
+		ag_require.NoError(t, err)
+		ag_require.Equal(t,
+			[]*ag_solanago.AccountMeta{
+				ag_solanago.Meta(programData).WRITE(),
+				ag_solanago.Meta(currentAuthority).SIGNER(),
+			},
+			inst.Accounts(),
+		)
+
+		data, err := inst.Data()
+		ag_require.NoError(t, err)
+		decoded, err := DecodeInstruction(inst.Accounts(), data)
+		ag_require.NoError(t, err)
+		ag_require.Nil(t, decoded.Impl.(*SetAuthority).GetNewAuthorityAccount())
+	}
+	{
+		inst := NewSetUpgradeAuthorityInstruction(programData, currentAuthority, &newAuthority)
+		inst.AccountMetaSlice[1] = ag_solanago.Meta(currentAuthority)
+		_, err := inst.ValidateAndBuild()
+		ag_require.EqualError(t, err, "CurrentAuthorityAccount must be a signer")
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bpfloader
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_format "github.com/gagliardetto/solana-go/text/format"
+	ag_treeout "github.com/gagliardetto/treeout"
+)
+
+// Upgrade a program.
+type Upgrade struct {
+
+	// [0] = [WRITE] ProgramDataAccount
+	// ··········· The ProgramData account
+	//
+	// [1] = [WRITE] ProgramAccount
+	// ··········· The Program account
+	//
+	// [2] = [WRITE] BufferAccount
+	// ··········· The Buffer account where the program data has been written
+	//
+	// [3] = [WRITE] SpillAccount
+	// ··········· The spill account, which receives the Buffer's lamports
+	//
+	// [4] = [] $(SysVarRentPubkey)
+	// ··········· Rent sysvar
+	//
+	// [5] = [] $(SysVarClockPubkey)
+	// ··········· Clock sysvar
+	//
+	// [6] = [SIGNER] AuthorityAccount
+	// ··········· The program's authority
+	ag_solanago.AccountMetaSlice `bin:"-" borsh_skip:"true"`
+}
+
+// NewUpgradeInstructionBuilder creates a new `Upgrade` instruction builder.
+func NewUpgradeInstructionBuilder() *Upgrade {
+	nd := &Upgrade{
+		AccountMetaSlice: make(ag_solanago.AccountMetaSlice, 7),
+	}
+	nd.AccountMetaSlice[4] = ag_solanago.Meta(ag_solanago.SysVarRentPubkey)
+	nd.AccountMetaSlice[5] = ag_solanago.Meta(ag_solanago.SysVarClockPubkey)
+	return nd
+}
+
+// The ProgramData account
+func (inst *Upgrade) SetProgramDataAccount(programDataAccount ag_solanago.PublicKey) *Upgrade {
+	inst.AccountMetaSlice[0] = ag_solanago.Meta(programDataAccount).WRITE()
+	return inst
+}
+
+func (inst *Upgrade) GetProgramDataAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[0]
+}
+
+// The Program account
+func (inst *Upgrade) SetProgramAccount(programAccount ag_solanago.PublicKey) *Upgrade {
+	inst.AccountMetaSlice[1] = ag_solanago.Meta(programAccount).WRITE()
+	return inst
+}
+
+func (inst *Upgrade) GetProgramAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[1]
+}
+
+// The Buffer account where the program data has been written
+func (inst *Upgrade) SetBufferAccount(bufferAccount ag_solanago.PublicKey) *Upgrade {
+	inst.AccountMetaSlice[2] = ag_solanago.Meta(bufferAccount).WRITE()
+	return inst
+}
+
+func (inst *Upgrade) GetBufferAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[2]
+}
+
+// The spill account, which receives the Buffer's lamports
+func (inst *Upgrade) SetSpillAccount(spillAccount ag_solanago.PublicKey) *Upgrade {
+	inst.AccountMetaSlice[3] = ag_solanago.Meta(spillAccount).WRITE()
+	return inst
+}
+
+func (inst *Upgrade) GetSpillAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[3]
+}
+
+// Rent sysvar
+func (inst *Upgrade) SetSysVarRentPubkeyAccount(SysVarRentPubkey ag_solanago.PublicKey) *Upgrade {
+	inst.AccountMetaSlice[4] = ag_solanago.Meta(SysVarRentPubkey)
+	return inst
+}
+
+func (inst *Upgrade) GetSysVarRentPubkeyAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[4]
+}
+
+// Clock sysvar
+func (inst *Upgrade) SetSysVarClockPubkeyAccount(SysVarClockPubkey ag_solanago.PublicKey) *Upgrade {
+	inst.AccountMetaSlice[5] = ag_solanago.Meta(SysVarClockPubkey)
+	return inst
+}
+
+func (inst *Upgrade) GetSysVarClockPubkeyAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[5]
+}
+
+// The program's authority
+func (inst *Upgrade) SetAuthorityAccount(authorityAccount ag_solanago.PublicKey) *Upgrade {
+	inst.AccountMetaSlice[6] = ag_solanago.Meta(authorityAccount).SIGNER()
+	return inst
+}
+
+func (inst *Upgrade) GetAuthorityAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[6]
+}
+
+func (inst Upgrade) Build() *Instruction {
+	return &Instruction{BaseVariant: ag_binary.BaseVariant{
+		Impl:   inst,
+		TypeID: ag_binary.TypeIDFromUint32(Instruction_Upgrade, binary.LittleEndian),
+	}}
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (inst Upgrade) ValidateAndBuild() (*Instruction, error) {
+	if err := inst.Validate(); err != nil {
+		return nil, err
+	}
+	return inst.Build(), nil
+}
+
+func (inst *Upgrade) Validate() error {
+	// Check whether all accounts are set:
+	for accIndex, acc := range inst.AccountMetaSlice {
+		if acc == nil {
+			return fmt.Errorf("ins.AccountMetaSlice[%v] is not set", accIndex)
+		}
+	}
+	if !inst.AccountMetaSlice[6].IsSigner {
+		return errors.New("AuthorityAccount must be a signer")
+	}
+	return nil
+}
+
+func (inst *Upgrade) EncodeToTree(parent ag_treeout.Branches) {
+	parent.Child(ag_format.Program(ProgramName, ProgramID)).
+		//
+		ParentFunc(func(programBranch ag_treeout.Branches) {
+			programBranch.Child(ag_format.Instruction("Upgrade")).
+				//
+				ParentFunc(func(instructionBranch ag_treeout.Branches) {
+
+					// Parameters of the instruction:
+					instructionBranch.Child("Params").ParentFunc(func(paramsBranch ag_treeout.Branches) {})
+
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts").ParentFunc(func(accountsBranch ag_treeout.Branches) {
+						accountsBranch.Child(ag_format.Meta("ProgramData", inst.AccountMetaSlice[0]))
+						accountsBranch.Child(ag_format.Meta("    Program", inst.AccountMetaSlice[1]))
+						accountsBranch.Child(ag_format.Meta("     Buffer", inst.AccountMetaSlice[2]))
+						accountsBranch.Child(ag_format.Meta("      Spill", inst.AccountMetaSlice[3]))
+						accountsBranch.Child(ag_format.Meta(" SysVarRent", inst.AccountMetaSlice[4]))
+						accountsBranch.Child(ag_format.Meta("SysVarClock", inst.AccountMetaSlice[5]))
+						accountsBranch.Child(ag_format.Meta("  Authority", inst.AccountMetaSlice[6]))
+					})
+				})
+		})
+}
+
+func (inst Upgrade) MarshalWithEncoder(encoder *ag_binary.Encoder) error {
+	return nil
+}
+
+func (inst *Upgrade) UnmarshalWithDecoder(decoder *ag_binary.Decoder) error {
+	return nil
+}
+
+// NewUpgradeInstruction declares a new Upgrade instruction with the provided parameters and accounts.
+func NewUpgradeInstruction(
+	// Accounts:
+	programDataAccount ag_solanago.PublicKey,
+	programAccount ag_solanago.PublicKey,
+	bufferAccount ag_solanago.PublicKey,
+	spillAccount ag_solanago.PublicKey,
+	SysVarRentPubkey ag_solanago.PublicKey,
+	SysVarClockPubkey ag_solanago.PublicKey,
+	authorityAccount ag_solanago.PublicKey) *Upgrade {
+	return NewUpgradeInstructionBuilder().
+		SetProgramDataAccount(programDataAccount).
+		SetProgramAccount(programAccount).
+		SetBufferAccount(bufferAccount).
+		SetSpillAccount(spillAccount).
+		SetSysVarRentPubkeyAccount(SysVarRentPubkey).
+		SetSysVarClockPubkeyAccount(SysVarClockPubkey).
+		SetAuthorityAccount(authorityAccount)
+}
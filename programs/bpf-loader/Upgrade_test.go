@@ -0,0 +1,88 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bpfloader
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_require "github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode_Upgrade(t *testing.T) {
+	fu := ag_gofuzz.New().NilChance(0)
+	for i := 0; i < 1; i++ {
+		t.Run("Upgrade"+strconv.Itoa(i), func(t *testing.T) {
+			{
+				params := new(Upgrade)
+				fu.Fuzz(params)
+				params.AccountMetaSlice = nil
+				buf := new(bytes.Buffer)
+				err := encodeT(*params, buf)
+				ag_require.NoError(t, err)
+				//
+				got := new(Upgrade)
+				err = decodeT(got, buf.Bytes())
+				got.AccountMetaSlice = nil
+				ag_require.NoError(t, err)
+				ag_require.Equal(t, params, got)
+			}
+		})
+	}
+}
+
+func TestNewUpgradeInstruction(t *testing.T) {
+	programData := ag_solanago.NewWallet().PublicKey()
+	program := ag_solanago.NewWallet().PublicKey()
+	buffer := ag_solanago.NewWallet().PublicKey()
+	spill := ag_solanago.NewWallet().PublicKey()
+	authority := ag_solanago.NewWallet().PublicKey()
+
+	inst, err := NewUpgradeInstruction(
+		programData,
+		program,
+		buffer,
+		spill,
+		ag_solanago.SysVarRentPubkey,
+		ag_solanago.SysVarClockPubkey,
+		authority,
+	).ValidateAndBuild()
+	ag_require.NoError(t, err)
+
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, []byte{3, 0, 0, 0}, data)
+
+	ag_require.Equal(t,
+		[]*ag_solanago.AccountMeta{
+			ag_solanago.Meta(programData).WRITE(),
+			ag_solanago.Meta(program).WRITE(),
+			ag_solanago.Meta(buffer).WRITE(),
+			ag_solanago.Meta(spill).WRITE(),
+			ag_solanago.Meta(ag_solanago.SysVarRentPubkey),
+			ag_solanago.Meta(ag_solanago.SysVarClockPubkey),
+			ag_solanago.Meta(authority).SIGNER(),
+		},
+		inst.Accounts(),
+	)
+
+	unsigned := NewUpgradeInstruction(programData, program, buffer, spill, ag_solanago.SysVarRentPubkey, ag_solanago.SysVarClockPubkey, authority)
+	unsigned.AccountMetaSlice[6] = ag_solanago.Meta(authority)
+	_, err = unsigned.ValidateAndBuild()
+	ag_require.EqualError(t, err, "AuthorityAccount must be a signer")
+}
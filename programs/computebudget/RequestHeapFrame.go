@@ -0,0 +1,132 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package computebudget
+
+import (
+	"errors"
+	"fmt"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_format "github.com/gagliardetto/solana-go/text/format"
+	ag_treeout "github.com/gagliardetto/treeout"
+)
+
+// Request a specific transaction-wide program heap region size in bytes.
+// The value requested must be a multiple of 1024.
+type RequestHeapFrame struct {
+	// Requested heap size in bytes.
+	Bytes *uint32
+
+	ag_solanago.AccountMetaSlice `bin:"-" borsh_skip:"true"`
+}
+
+// NewRequestHeapFrameInstructionBuilder creates a new `RequestHeapFrame` instruction builder.
+func NewRequestHeapFrameInstructionBuilder() *RequestHeapFrame {
+	nd := &RequestHeapFrame{
+		AccountMetaSlice: make(ag_solanago.AccountMetaSlice, 0),
+	}
+	return nd
+}
+
+// Requested heap size in bytes.
+func (inst *RequestHeapFrame) SetBytes(bytes uint32) *RequestHeapFrame {
+	inst.Bytes = &bytes
+	return inst
+}
+
+func (inst RequestHeapFrame) Build() *Instruction {
+	return &Instruction{BaseVariant: ag_binary.BaseVariant{
+		Impl:   inst,
+		TypeID: ag_binary.TypeIDFromUint8(Instruction_RequestHeapFrame),
+	}}
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (inst RequestHeapFrame) ValidateAndBuild() (*Instruction, error) {
+	if err := inst.Validate(); err != nil {
+		return nil, err
+	}
+	return inst.Build(), nil
+}
+
+func (inst *RequestHeapFrame) Validate() error {
+	// Check whether all (required) parameters are set:
+	{
+		if inst.Bytes == nil {
+			return errors.New("Bytes parameter is not set")
+		}
+	}
+
+	// Check whether all accounts are set:
+	for accIndex, acc := range inst.AccountMetaSlice {
+		if acc == nil {
+			return fmt.Errorf("ins.AccountMetaSlice[%v] is not set", accIndex)
+		}
+	}
+	return nil
+}
+
+func (inst *RequestHeapFrame) EncodeToTree(parent ag_treeout.Branches) {
+	parent.Child(ag_format.Program(ProgramName, ProgramID)).
+		//
+		ParentFunc(func(programBranch ag_treeout.Branches) {
+			programBranch.Child(ag_format.Instruction("RequestHeapFrame")).
+				//
+				ParentFunc(func(instructionBranch ag_treeout.Branches) {
+
+					// Parameters of the instruction:
+					instructionBranch.Child("Params").ParentFunc(func(paramsBranch ag_treeout.Branches) {
+						paramsBranch.Child(ag_format.Param("Bytes", *inst.Bytes))
+					})
+
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts").ParentFunc(func(accountsBranch ag_treeout.Branches) {})
+				})
+		})
+}
+
+func (inst RequestHeapFrame) MarshalWithEncoder(encoder *ag_binary.Encoder) error {
+	// Serialize `Bytes` param:
+	{
+		err := encoder.Encode(*inst.Bytes)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (inst *RequestHeapFrame) UnmarshalWithDecoder(decoder *ag_binary.Decoder) error {
+	// Deserialize `Bytes` param:
+	{
+		err := decoder.Decode(&inst.Bytes)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewRequestHeapFrameInstruction declares a new RequestHeapFrame instruction with the provided parameters and accounts.
+func NewRequestHeapFrameInstruction(
+	// Parameters:
+	bytes uint32,
+) *RequestHeapFrame {
+	return NewRequestHeapFrameInstructionBuilder().
+		SetBytes(bytes)
+}
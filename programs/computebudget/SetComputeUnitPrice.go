@@ -0,0 +1,132 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package computebudget
+
+import (
+	"errors"
+	"fmt"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_format "github.com/gagliardetto/solana-go/text/format"
+	ag_treeout "github.com/gagliardetto/treeout"
+)
+
+// Set a compute unit price in "micro-lamports" to pay a higher transaction
+// fee for higher transaction prioritization.
+type SetComputeUnitPrice struct {
+	// Price per compute unit, in micro-lamports.
+	MicroLamports *uint64
+
+	ag_solanago.AccountMetaSlice `bin:"-" borsh_skip:"true"`
+}
+
+// NewSetComputeUnitPriceInstructionBuilder creates a new `SetComputeUnitPrice` instruction builder.
+func NewSetComputeUnitPriceInstructionBuilder() *SetComputeUnitPrice {
+	nd := &SetComputeUnitPrice{
+		AccountMetaSlice: make(ag_solanago.AccountMetaSlice, 0),
+	}
+	return nd
+}
+
+// Price per compute unit, in micro-lamports.
+func (inst *SetComputeUnitPrice) SetMicroLamports(microLamports uint64) *SetComputeUnitPrice {
+	inst.MicroLamports = &microLamports
+	return inst
+}
+
+func (inst SetComputeUnitPrice) Build() *Instruction {
+	return &Instruction{BaseVariant: ag_binary.BaseVariant{
+		Impl:   inst,
+		TypeID: ag_binary.TypeIDFromUint8(Instruction_SetComputeUnitPrice),
+	}}
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (inst SetComputeUnitPrice) ValidateAndBuild() (*Instruction, error) {
+	if err := inst.Validate(); err != nil {
+		return nil, err
+	}
+	return inst.Build(), nil
+}
+
+func (inst *SetComputeUnitPrice) Validate() error {
+	// Check whether all (required) parameters are set:
+	{
+		if inst.MicroLamports == nil {
+			return errors.New("MicroLamports parameter is not set")
+		}
+	}
+
+	// Check whether all accounts are set:
+	for accIndex, acc := range inst.AccountMetaSlice {
+		if acc == nil {
+			return fmt.Errorf("ins.AccountMetaSlice[%v] is not set", accIndex)
+		}
+	}
+	return nil
+}
+
+func (inst *SetComputeUnitPrice) EncodeToTree(parent ag_treeout.Branches) {
+	parent.Child(ag_format.Program(ProgramName, ProgramID)).
+		//
+		ParentFunc(func(programBranch ag_treeout.Branches) {
+			programBranch.Child(ag_format.Instruction(fmt.Sprintf("SetComputeUnitPrice: %d µLamports/CU", *inst.MicroLamports))).
+				//
+				ParentFunc(func(instructionBranch ag_treeout.Branches) {
+
+					// Parameters of the instruction:
+					instructionBranch.Child("Params").ParentFunc(func(paramsBranch ag_treeout.Branches) {
+						paramsBranch.Child(ag_format.Param("MicroLamports", *inst.MicroLamports))
+					})
+
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts").ParentFunc(func(accountsBranch ag_treeout.Branches) {})
+				})
+		})
+}
+
+func (inst SetComputeUnitPrice) MarshalWithEncoder(encoder *ag_binary.Encoder) error {
+	// Serialize `MicroLamports` param:
+	{
+		err := encoder.Encode(*inst.MicroLamports)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (inst *SetComputeUnitPrice) UnmarshalWithDecoder(decoder *ag_binary.Decoder) error {
+	// Deserialize `MicroLamports` param:
+	{
+		err := decoder.Decode(&inst.MicroLamports)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewSetComputeUnitPriceInstruction declares a new SetComputeUnitPrice instruction with the provided parameters and accounts.
+func NewSetComputeUnitPriceInstruction(
+	// Parameters:
+	microLamports uint64,
+) *SetComputeUnitPrice {
+	return NewSetComputeUnitPriceInstructionBuilder().
+		SetMicroLamports(microLamports)
+}
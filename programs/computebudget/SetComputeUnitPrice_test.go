@@ -0,0 +1,75 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package computebudget
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_text "github.com/gagliardetto/solana-go/text"
+	ag_require "github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode_SetComputeUnitPrice(t *testing.T) {
+	fu := ag_gofuzz.New().NilChance(0)
+	for i := 0; i < 1; i++ {
+		t.Run("SetComputeUnitPrice"+strconv.Itoa(i), func(t *testing.T) {
+			{
+				params := new(SetComputeUnitPrice)
+				fu.Fuzz(params)
+				params.AccountMetaSlice = nil
+				buf := new(bytes.Buffer)
+				err := encodeT(*params, buf)
+				ag_require.NoError(t, err)
+				//
+				got := new(SetComputeUnitPrice)
+				err = decodeT(got, buf.Bytes())
+				got.AccountMetaSlice = nil
+				ag_require.NoError(t, err)
+				ag_require.Equal(t, params, got)
+			}
+		})
+	}
+}
+
+func TestData_SetComputeUnitPrice(t *testing.T) {
+	inst, err := NewSetComputeUnitPriceInstruction(1000000).ValidateAndBuild()
+	ag_require.NoError(t, err)
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	// Discriminant 3, followed by the u64 price in little endian.
+	ag_require.Equal(t, []byte{0x03, 0x40, 0x42, 0x0f, 0x00, 0x00, 0x00, 0x00, 0x00}, data)
+	ag_require.Empty(t, inst.Accounts())
+
+	got, err := DecodeInstruction(nil, data)
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, uint64(1000000), *got.Impl.(*SetComputeUnitPrice).MicroLamports)
+}
+
+func TestEncodeToTree_SetComputeUnitPrice(t *testing.T) {
+	ag_text.DisableColors = true
+	defer func() { ag_text.DisableColors = false }()
+
+	buf := new(bytes.Buffer)
+	enc := ag_text.NewTreeEncoder(buf, "")
+	data, err := NewSetComputeUnitPriceInstruction(5000).Build().Data()
+	ag_require.NoError(t, err)
+	inst, err := DecodeInstruction(nil, data)
+	ag_require.NoError(t, err)
+	inst.EncodeToTree(enc)
+	ag_require.Contains(t, enc.Tree.String(), "Instruction: SetComputeUnitPrice: 5000 µLamports/CU")
+}
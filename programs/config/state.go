@@ -0,0 +1,156 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+)
+
+var (
+	// ValidatorInfoID is the first key of the config accounts that hold validator info.
+	ValidatorInfoID = ag_solanago.MustPublicKeyFromBase58("Va1idator1nfo111111111111111111111111111111")
+
+	// StakeConfigID is the address of the stake config account.
+	StakeConfigID = ag_solanago.MustPublicKeyFromBase58("StakeConfig11111111111111111111111111111111")
+)
+
+// ConfigKey is a key of a config account, and whether it must sign
+// to update the account.
+type ConfigKey struct {
+	PublicKey ag_solanago.PublicKey
+	Signer    bool
+}
+
+// ConfigAccount is the data of an account owned by the Config program:
+// a list of keys followed by the program-specific data.
+type ConfigAccount struct {
+	Keys []ConfigKey
+	// Data is the data following the keys.
+	Data []byte
+}
+
+// DecodeConfigAccount decodes the data of an account owned by the Config program.
+func DecodeConfigAccount(data []byte) (*ConfigAccount, error) {
+	decoder := ag_binary.NewBinDecoder(data)
+	count, err := decoder.ReadCompactU16()
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode config keys length: %w", err)
+	}
+	if count > decoder.Remaining()/33 {
+		return nil, fmt.Errorf("invalid config account: %d keys declared, data holds at most %d", count, decoder.Remaining()/33)
+	}
+	out := &ConfigAccount{
+		Keys: make([]ConfigKey, count),
+	}
+	for i := range out.Keys {
+		if err := decoder.Decode(&out.Keys[i]); err != nil {
+			return nil, fmt.Errorf("unable to decode config key %d: %w", i, err)
+		}
+	}
+	out.Data, err = decoder.ReadNBytes(decoder.Remaining())
+	if err != nil {
+		return nil, fmt.Errorf("unable to read config data: %w", err)
+	}
+	return out, nil
+}
+
+// IsValidatorInfo returns true if the config account holds validator info.
+func (acc *ConfigAccount) IsValidatorInfo() bool {
+	return len(acc.Keys) > 0 && acc.Keys[0].PublicKey.Equals(ValidatorInfoID)
+}
+
+// IsStakeConfig returns true if the provided address is the stake config account.
+// The stake config account has no keys, so it can only be identified by its address.
+func IsStakeConfig(address ag_solanago.PublicKey) bool {
+	return address.Equals(StakeConfigID)
+}
+
+// ValidatorInfo is the identity metadata published by a validator.
+type ValidatorInfo struct {
+	// Identity is the identity key of the validator.
+	Identity ag_solanago.PublicKey `json:"-"`
+
+	Name            string `json:"name,omitempty"`
+	Website         string `json:"website,omitempty"`
+	Details         string `json:"details,omitempty"`
+	KeybaseUsername string `json:"keybaseUsername,omitempty"`
+	IconURL         string `json:"iconUrl,omitempty"`
+}
+
+// DecodeValidatorInfo decodes the validator info from the data
+// of a config account.
+func DecodeValidatorInfo(data []byte) (*ValidatorInfo, error) {
+	acc, err := DecodeConfigAccount(data)
+	if err != nil {
+		return nil, err
+	}
+	if !acc.IsValidatorInfo() {
+		return nil, fmt.Errorf("not a validator info config account")
+	}
+	if len(acc.Keys) < 2 {
+		return nil, fmt.Errorf("invalid validator info: missing identity key")
+	}
+
+	raw, err := ag_binary.NewBinDecoder(acc.Data).ReadRustString()
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode validator info: %w", err)
+	}
+	out := new(ValidatorInfo)
+	if err := json.Unmarshal([]byte(raw), out); err != nil {
+		return nil, fmt.Errorf("unable to decode validator info json: %w", err)
+	}
+	out.Identity = acc.Keys[1].PublicKey
+	return out, nil
+}
+
+// StakeConfig is the content of the stake config account.
+type StakeConfig struct {
+	// How much stake we can activate/deactivate per-epoch as a fraction of currently effective stake.
+	WarmupCooldownRate float64
+	// Percentage of stake lost when slashed.
+	SlashPenalty uint8
+}
+
+// Size of the stake config: the warmup cooldown rate (f64) and the slash penalty (u8).
+const stakeConfigSize = 8 + 1
+
+// DecodeStakeConfig decodes the stake config from the data
+// of the stake config account (see IsStakeConfig).
+func DecodeStakeConfig(data []byte) (*StakeConfig, error) {
+	acc, err := DecodeConfigAccount(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(acc.Data) != stakeConfigSize {
+		return nil, fmt.Errorf("not a stake config account: config data is %d bytes, expected %d", len(acc.Data), stakeConfigSize)
+	}
+
+	decoder := ag_binary.NewBinDecoder(acc.Data)
+	out := new(StakeConfig)
+	out.WarmupCooldownRate, err = decoder.ReadFloat64(binary.LittleEndian)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode warmup cooldown rate: %w", err)
+	}
+	out.SlashPenalty, err = decoder.ReadUint8()
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode slash penalty: %w", err)
+	}
+	return out, nil
+}
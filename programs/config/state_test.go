@@ -0,0 +1,95 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/base64"
+	"testing"
+
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_require "github.com/stretchr/testify/require"
+)
+
+// A validator-info config account, as returned by getAccountInfo.
+const validatorInfoAccountData = "AgdRlwF0SPKsXcI8nrx6x4wKJyV6xhRFjeCk8W+AAAAAAF68hTcsFSGbzOqwW8Xn4U30dQEzTIvP1M0HFncXwNrBAX8AAAAAAAAAeyJuYW1lIjoiQ2VydHVzIE9uZSIsIndlYnNpdGUiOiJodHRwczovL2NlcnR1cy5vbmUiLCJkZXRhaWxzIjoiU2VjdXJpbmcgcHJvb2Ytb2Ytc3Rha2UgbmV0d29ya3MiLCJrZXliYXNlVXNlcm5hbWUiOiJjZXJ0dXNvbmUifQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"
+
+// The stake config account (StakeConfig11111111111111111111111111111111), as returned by getAccountInfo:
+// it is created with no keys.
+const stakeConfigAccountData = "AAAAAAAAANA/DA=="
+
+func mustBase64Decode(t *testing.T, s string) []byte {
+	data, err := base64.StdEncoding.DecodeString(s)
+	ag_require.NoError(t, err)
+	return data
+}
+
+func TestDecodeConfigAccount(t *testing.T) {
+	acc, err := DecodeConfigAccount(mustBase64Decode(t, validatorInfoAccountData))
+	ag_require.NoError(t, err)
+	ag_require.Equal(t,
+		[]ConfigKey{
+			{PublicKey: ValidatorInfoID, Signer: false},
+			{PublicKey: ag_solanago.MustPublicKeyFromBase58("7Np41oeYqPefeNQEHSv1UDhYrehxin3NStELsSKCT4K2"), Signer: true},
+		},
+		acc.Keys,
+	)
+	ag_require.True(t, acc.IsValidatorInfo())
+
+	_, err = DecodeConfigAccount([]byte{5, 0, 0})
+	ag_require.Error(t, err)
+}
+
+func TestDecodeValidatorInfo(t *testing.T) {
+	info, err := DecodeValidatorInfo(mustBase64Decode(t, validatorInfoAccountData))
+	ag_require.NoError(t, err)
+	ag_require.Equal(t,
+		&ValidatorInfo{
+			Identity:        ag_solanago.MustPublicKeyFromBase58("7Np41oeYqPefeNQEHSv1UDhYrehxin3NStELsSKCT4K2"),
+			Name:            "Certus One",
+			Website:         "https://certus.one",
+			Details:         "Securing proof-of-stake networks",
+			KeybaseUsername: "certusone",
+		},
+		info,
+	)
+
+	_, err = DecodeValidatorInfo(mustBase64Decode(t, stakeConfigAccountData))
+	ag_require.Error(t, err)
+}
+
+func TestDecodeStakeConfig(t *testing.T) {
+	conf, err := DecodeStakeConfig(mustBase64Decode(t, stakeConfigAccountData))
+	ag_require.NoError(t, err)
+	ag_require.Equal(t,
+		&StakeConfig{
+			WarmupCooldownRate: 0.25,
+			SlashPenalty:       12,
+		},
+		conf,
+	)
+
+	acc, err := DecodeConfigAccount(mustBase64Decode(t, stakeConfigAccountData))
+	ag_require.NoError(t, err)
+	ag_require.Empty(t, acc.Keys)
+	ag_require.False(t, acc.IsValidatorInfo())
+
+	_, err = DecodeStakeConfig(mustBase64Decode(t, validatorInfoAccountData))
+	ag_require.Error(t, err)
+}
+
+func TestIsStakeConfig(t *testing.T) {
+	ag_require.True(t, IsStakeConfig(ag_solanago.MustPublicKeyFromBase58("StakeConfig11111111111111111111111111111111")))
+	ag_require.False(t, IsStakeConfig(ValidatorInfoID))
+}
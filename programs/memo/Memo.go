@@ -0,0 +1,156 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memo
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_format "github.com/gagliardetto/solana-go/text/format"
+	ag_treeout "github.com/gagliardetto/treeout"
+)
+
+// Memo writes a UTF-8 string to the transaction log;
+// the instruction data is the raw memo bytes.
+type Memo struct {
+	// The memo message.
+	Memo *string
+
+	// [0...] = [SIGNER] Signers
+	// ··········· Optional accounts that must sign the transaction
+	ag_solanago.AccountMetaSlice `bin:"-" borsh_skip:"true"`
+}
+
+// NewMemoInstructionBuilder creates a new `Memo` instruction builder.
+func NewMemoInstructionBuilder() *Memo {
+	nd := &Memo{
+		AccountMetaSlice: make(ag_solanago.AccountMetaSlice, 0),
+	}
+	return nd
+}
+
+// The memo message.
+func (inst *Memo) SetMemo(memo string) *Memo {
+	inst.Memo = &memo
+	return inst
+}
+
+// Optional accounts that must sign the transaction.
+func (inst *Memo) SetSigners(signers ...ag_solanago.PublicKey) *Memo {
+	inst.AccountMetaSlice = make(ag_solanago.AccountMetaSlice, 0, len(signers))
+	for _, signer := range signers {
+		inst.AccountMetaSlice.Append(ag_solanago.Meta(signer).SIGNER())
+	}
+	return inst
+}
+
+func (inst Memo) Build() *Instruction {
+	return &Instruction{BaseVariant: ag_binary.BaseVariant{
+		Impl:   inst,
+		TypeID: ag_binary.NoTypeIDDefaultID,
+	}}
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (inst Memo) ValidateAndBuild() (*Instruction, error) {
+	if err := inst.Validate(); err != nil {
+		return nil, err
+	}
+	return inst.Build(), nil
+}
+
+func (inst *Memo) Validate() error {
+	// Check whether all (required) parameters are set:
+	{
+		if inst.Memo == nil {
+			return errors.New("Memo parameter is not set")
+		}
+		if len(*inst.Memo) == 0 {
+			return errors.New("Memo parameter is empty")
+		}
+		if len(*inst.Memo) > ag_solanago.MaxMemoSize {
+			return fmt.Errorf("Memo parameter is too long: %d bytes, max is %d", len(*inst.Memo), ag_solanago.MaxMemoSize)
+		}
+		if !utf8.ValidString(*inst.Memo) {
+			return errors.New("Memo parameter is not valid UTF-8")
+		}
+	}
+
+	// Check whether all accounts are set:
+	for accIndex, acc := range inst.AccountMetaSlice {
+		if acc == nil {
+			return fmt.Errorf("ins.AccountMetaSlice[%v] is not set", accIndex)
+		}
+	}
+	return nil
+}
+
+func (inst *Memo) EncodeToTree(parent ag_treeout.Branches) {
+	parent.Child(ag_format.Program(ProgramName, ProgramID)).
+		//
+		ParentFunc(func(programBranch ag_treeout.Branches) {
+			programBranch.Child(ag_format.Instruction("Memo")).
+				//
+				ParentFunc(func(instructionBranch ag_treeout.Branches) {
+
+					// Parameters of the instruction:
+					instructionBranch.Child("Params").ParentFunc(func(paramsBranch ag_treeout.Branches) {
+						paramsBranch.Child(ag_format.Param("Memo", *inst.Memo))
+					})
+
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts").ParentFunc(func(accountsBranch ag_treeout.Branches) {
+						signersBranch := accountsBranch.Child(fmt.Sprintf("signers[len=%v]", len(inst.AccountMetaSlice)))
+						for i, v := range inst.AccountMetaSlice {
+							if len(inst.AccountMetaSlice) > 9 && i < 10 {
+								signersBranch.Child(ag_format.Meta(fmt.Sprintf(" [%v]", i), v))
+							} else {
+								signersBranch.Child(ag_format.Meta(fmt.Sprintf("[%v]", i), v))
+							}
+						}
+					})
+				})
+		})
+}
+
+func (inst Memo) MarshalWithEncoder(encoder *ag_binary.Encoder) error {
+	return encoder.WriteBytes([]byte(*inst.Memo), false)
+}
+
+func (inst *Memo) UnmarshalWithDecoder(decoder *ag_binary.Decoder) error {
+	data, err := decoder.ReadNBytes(decoder.Remaining())
+	if err != nil {
+		return err
+	}
+	memo := string(data)
+	inst.Memo = &memo
+	return nil
+}
+
+// NewMemoInstruction declares a new Memo instruction with the provided parameters and accounts.
+func NewMemoInstruction(
+	// Parameters:
+	memo string,
+	// Accounts:
+	signers ...ag_solanago.PublicKey) *Memo {
+	return NewMemoInstructionBuilder().
+		SetMemo(memo).
+		SetSigners(signers...)
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memo
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_text "github.com/gagliardetto/solana-go/text"
+	ag_require "github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode_Memo(t *testing.T) {
+	signer := ag_solanago.NewWallet().PublicKey()
+	inst := NewMemoInstruction("hello, 世界", signer).Build()
+
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, []byte("hello, 世界"), data)
+
+	ag_require.Equal(t, ag_solanago.AccountMetaSlice{ag_solanago.Meta(signer).SIGNER()}, ag_solanago.AccountMetaSlice(inst.Accounts()))
+
+	got, err := DecodeInstruction(inst.Accounts(), data)
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, "hello, 世界", *got.Impl.(*Memo).Memo)
+}
+
+func TestValidate_Memo(t *testing.T) {
+	inst, err := NewMemoInstruction("order #1234").ValidateAndBuild()
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, ag_solanago.MemoProgramID, inst.ProgramID())
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, []byte("order #1234"), data)
+
+	_, err = NewMemoInstructionBuilder().ValidateAndBuild()
+	ag_require.EqualError(t, err, "Memo parameter is not set")
+
+	_, err = NewMemoInstruction("").ValidateAndBuild()
+	ag_require.EqualError(t, err, "Memo parameter is empty")
+
+	_, err = NewMemoInstruction(strings.Repeat("a", ag_solanago.MaxMemoSize)).ValidateAndBuild()
+	ag_require.NoError(t, err)
+	_, err = NewMemoInstruction(strings.Repeat("a", ag_solanago.MaxMemoSize+1)).ValidateAndBuild()
+	ag_require.EqualError(t, err, "Memo parameter is too long: 567 bytes, max is 566")
+
+	_, err = NewMemoInstruction(string([]byte{0xff, 0xfe})).ValidateAndBuild()
+	ag_require.EqualError(t, err, "Memo parameter is not valid UTF-8")
+}
+
+func TestEncodeToTree_Memo(t *testing.T) {
+	ag_text.DisableColors = true
+	defer func() { ag_text.DisableColors = false }()
+
+	buf := new(bytes.Buffer)
+	enc := ag_text.NewTreeEncoder(buf, "")
+	data, err := NewMemoInstruction("hello, 世界").Build().Data()
+	ag_require.NoError(t, err)
+	inst, err := DecodeInstruction(nil, data)
+	ag_require.NoError(t, err)
+	inst.EncodeToTree(enc)
+	ag_require.Contains(t, enc.Tree.String(), "Memo: (string) (len=13) \"hello, 世界\"")
+}
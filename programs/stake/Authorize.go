@@ -0,0 +1,210 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stake
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_format "github.com/gagliardetto/solana-go/text/format"
+	ag_treeout "github.com/gagliardetto/treeout"
+)
+
+// Authorizes a key to manage stake or withdrawal.
+type Authorize struct {
+	// The new authority.
+	NewAuthority *ag_solanago.PublicKey
+
+	// The kind of authority to change.
+	StakeAuthorize *StakeAuthorize
+
+	// [0] = [WRITE] StakeAccount
+	// ··········· Stake account to be updated
+	//
+	// [1] = [] $(SysVarClockPubkey)
+	// ··········· Clock sysvar
+	//
+	// [2] = [SIGNER] AuthorityAccount
+	// ··········· The stake or withdraw authority
+	ag_solanago.AccountMetaSlice `bin:"-" borsh_skip:"true"`
+}
+
+// NewAuthorizeInstructionBuilder creates a new `Authorize` instruction builder.
+func NewAuthorizeInstructionBuilder() *Authorize {
+	nd := &Authorize{
+		AccountMetaSlice: make(ag_solanago.AccountMetaSlice, 3),
+	}
+	nd.AccountMetaSlice[1] = ag_solanago.Meta(ag_solanago.SysVarClockPubkey)
+	return nd
+}
+
+// The new authority.
+func (inst *Authorize) SetNewAuthority(newAuthority ag_solanago.PublicKey) *Authorize {
+	inst.NewAuthority = &newAuthority
+	return inst
+}
+
+// The kind of authority to change.
+func (inst *Authorize) SetStakeAuthorize(stakeAuthorize StakeAuthorize) *Authorize {
+	inst.StakeAuthorize = &stakeAuthorize
+	return inst
+}
+
+// Stake account to be updated
+func (inst *Authorize) SetStakeAccount(stakeAccount ag_solanago.PublicKey) *Authorize {
+	inst.AccountMetaSlice[0] = ag_solanago.Meta(stakeAccount).WRITE()
+	return inst
+}
+
+func (inst *Authorize) GetStakeAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[0]
+}
+
+// Clock sysvar
+func (inst *Authorize) SetSysVarClockPubkeyAccount(SysVarClockPubkey ag_solanago.PublicKey) *Authorize {
+	inst.AccountMetaSlice[1] = ag_solanago.Meta(SysVarClockPubkey)
+	return inst
+}
+
+func (inst *Authorize) GetSysVarClockPubkeyAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[1]
+}
+
+// The stake or withdraw authority
+func (inst *Authorize) SetAuthorityAccount(authorityAccount ag_solanago.PublicKey) *Authorize {
+	inst.AccountMetaSlice[2] = ag_solanago.Meta(authorityAccount).SIGNER()
+	return inst
+}
+
+func (inst *Authorize) GetAuthorityAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[2]
+}
+
+func (inst Authorize) Build() *Instruction {
+	return &Instruction{BaseVariant: ag_binary.BaseVariant{
+		Impl:   inst,
+		TypeID: ag_binary.TypeIDFromUint32(Instruction_Authorize, binary.LittleEndian),
+	}}
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (inst Authorize) ValidateAndBuild() (*Instruction, error) {
+	if err := inst.Validate(); err != nil {
+		return nil, err
+	}
+	return inst.Build(), nil
+}
+
+func (inst *Authorize) Validate() error {
+	// Check whether all (required) parameters are set:
+	{
+		if inst.NewAuthority == nil {
+			return errors.New("NewAuthority parameter is not set")
+		}
+		if inst.StakeAuthorize == nil {
+			return errors.New("StakeAuthorize parameter is not set")
+		}
+	}
+
+	// Check whether all accounts are set:
+	for accIndex, acc := range inst.AccountMetaSlice {
+		if acc == nil {
+			return fmt.Errorf("ins.AccountMetaSlice[%v] is not set", accIndex)
+		}
+	}
+	return nil
+}
+
+func (inst *Authorize) EncodeToTree(parent ag_treeout.Branches) {
+	parent.Child(ag_format.Program(ProgramName, ProgramID)).
+		//
+		ParentFunc(func(programBranch ag_treeout.Branches) {
+			programBranch.Child(ag_format.Instruction("Authorize")).
+				//
+				ParentFunc(func(instructionBranch ag_treeout.Branches) {
+
+					// Parameters of the instruction:
+					instructionBranch.Child("Params").ParentFunc(func(paramsBranch ag_treeout.Branches) {
+						paramsBranch.Child(ag_format.Param("  NewAuthority", *inst.NewAuthority))
+						paramsBranch.Child(ag_format.Param("StakeAuthorize", *inst.StakeAuthorize))
+					})
+
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts").ParentFunc(func(accountsBranch ag_treeout.Branches) {
+						accountsBranch.Child(ag_format.Meta("      Stake", inst.AccountMetaSlice[0]))
+						accountsBranch.Child(ag_format.Meta("SysVarClock", inst.AccountMetaSlice[1]))
+						accountsBranch.Child(ag_format.Meta("  Authority", inst.AccountMetaSlice[2]))
+					})
+				})
+		})
+}
+
+func (inst Authorize) MarshalWithEncoder(encoder *ag_binary.Encoder) error {
+	// Serialize `NewAuthority` param:
+	{
+		err := encoder.Encode(*inst.NewAuthority)
+		if err != nil {
+			return err
+		}
+	}
+	// Serialize `StakeAuthorize` param:
+	{
+		err := encoder.Encode(*inst.StakeAuthorize)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (inst *Authorize) UnmarshalWithDecoder(decoder *ag_binary.Decoder) error {
+	// Deserialize `NewAuthority` param:
+	{
+		err := decoder.Decode(&inst.NewAuthority)
+		if err != nil {
+			return err
+		}
+	}
+	// Deserialize `StakeAuthorize` param:
+	{
+		err := decoder.Decode(&inst.StakeAuthorize)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewAuthorizeInstruction declares a new Authorize instruction with the provided parameters and accounts.
+func NewAuthorizeInstruction(
+	// Parameters:
+	newAuthority ag_solanago.PublicKey,
+	stakeAuthorize StakeAuthorize,
+	// Accounts:
+	stakeAccount ag_solanago.PublicKey,
+	SysVarClockPubkey ag_solanago.PublicKey,
+	authorityAccount ag_solanago.PublicKey) *Authorize {
+	return NewAuthorizeInstructionBuilder().
+		SetNewAuthority(newAuthority).
+		SetStakeAuthorize(stakeAuthorize).
+		SetStakeAccount(stakeAccount).
+		SetSysVarClockPubkeyAccount(SysVarClockPubkey).
+		SetAuthorityAccount(authorityAccount)
+}
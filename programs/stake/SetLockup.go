@@ -0,0 +1,164 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stake
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_format "github.com/gagliardetto/solana-go/text/format"
+	ag_treeout "github.com/gagliardetto/treeout"
+)
+
+// Sets the lockup of the stake account.
+type SetLockup struct {
+	// The lockup values to set; unset values are left unchanged.
+	LockupArgs *LockupArgs
+
+	// [0] = [WRITE] StakeAccount
+	// ··········· Initialized stake account
+	//
+	// [1] = [SIGNER] AuthorityAccount
+	// ··········· Lockup authority or withdraw authority
+	ag_solanago.AccountMetaSlice `bin:"-" borsh_skip:"true"`
+}
+
+// NewSetLockupInstructionBuilder creates a new `SetLockup` instruction builder.
+func NewSetLockupInstructionBuilder() *SetLockup {
+	nd := &SetLockup{
+		AccountMetaSlice: make(ag_solanago.AccountMetaSlice, 2),
+	}
+	return nd
+}
+
+// The lockup values to set; unset values are left unchanged.
+func (inst *SetLockup) SetLockupArgs(lockupArgs LockupArgs) *SetLockup {
+	inst.LockupArgs = &lockupArgs
+	return inst
+}
+
+// Initialized stake account
+func (inst *SetLockup) SetStakeAccount(stakeAccount ag_solanago.PublicKey) *SetLockup {
+	inst.AccountMetaSlice[0] = ag_solanago.Meta(stakeAccount).WRITE()
+	return inst
+}
+
+func (inst *SetLockup) GetStakeAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[0]
+}
+
+// Lockup authority or withdraw authority
+func (inst *SetLockup) SetAuthorityAccount(authorityAccount ag_solanago.PublicKey) *SetLockup {
+	inst.AccountMetaSlice[1] = ag_solanago.Meta(authorityAccount).SIGNER()
+	return inst
+}
+
+func (inst *SetLockup) GetAuthorityAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[1]
+}
+
+func (inst SetLockup) Build() *Instruction {
+	return &Instruction{BaseVariant: ag_binary.BaseVariant{
+		Impl:   inst,
+		TypeID: ag_binary.TypeIDFromUint32(Instruction_SetLockup, binary.LittleEndian),
+	}}
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (inst SetLockup) ValidateAndBuild() (*Instruction, error) {
+	if err := inst.Validate(); err != nil {
+		return nil, err
+	}
+	return inst.Build(), nil
+}
+
+func (inst *SetLockup) Validate() error {
+	// Check whether all (required) parameters are set:
+	{
+		if inst.LockupArgs == nil {
+			return errors.New("LockupArgs parameter is not set")
+		}
+	}
+
+	// Check whether all accounts are set:
+	for accIndex, acc := range inst.AccountMetaSlice {
+		if acc == nil {
+			return fmt.Errorf("ins.AccountMetaSlice[%v] is not set", accIndex)
+		}
+	}
+	return nil
+}
+
+func (inst *SetLockup) EncodeToTree(parent ag_treeout.Branches) {
+	parent.Child(ag_format.Program(ProgramName, ProgramID)).
+		//
+		ParentFunc(func(programBranch ag_treeout.Branches) {
+			programBranch.Child(ag_format.Instruction("SetLockup")).
+				//
+				ParentFunc(func(instructionBranch ag_treeout.Branches) {
+
+					// Parameters of the instruction:
+					instructionBranch.Child("Params").ParentFunc(func(paramsBranch ag_treeout.Branches) {
+						paramsBranch.Child(ag_format.Param("LockupArgs", *inst.LockupArgs))
+					})
+
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts").ParentFunc(func(accountsBranch ag_treeout.Branches) {
+						accountsBranch.Child(ag_format.Meta("    Stake", inst.AccountMetaSlice[0]))
+						accountsBranch.Child(ag_format.Meta("Authority", inst.AccountMetaSlice[1]))
+					})
+				})
+		})
+}
+
+func (inst SetLockup) MarshalWithEncoder(encoder *ag_binary.Encoder) error {
+	// Serialize `LockupArgs` param:
+	{
+		err := encoder.Encode(*inst.LockupArgs)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (inst *SetLockup) UnmarshalWithDecoder(decoder *ag_binary.Decoder) error {
+	// Deserialize `LockupArgs` param:
+	{
+		err := decoder.Decode(&inst.LockupArgs)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewSetLockupInstruction declares a new SetLockup instruction with the provided parameters and accounts.
+func NewSetLockupInstruction(
+	// Parameters:
+	lockupArgs LockupArgs,
+	// Accounts:
+	stakeAccount ag_solanago.PublicKey,
+	authorityAccount ag_solanago.PublicKey) *SetLockup {
+	return NewSetLockupInstructionBuilder().
+		SetLockupArgs(lockupArgs).
+		SetStakeAccount(stakeAccount).
+		SetAuthorityAccount(authorityAccount)
+}
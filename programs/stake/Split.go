@@ -0,0 +1,180 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stake
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_format "github.com/gagliardetto/solana-go/text/format"
+	ag_treeout "github.com/gagliardetto/treeout"
+)
+
+// Splits lamports from a stake account into another stake account.
+type Split struct {
+	// Number of lamports to move to the new stake account.
+	Lamports *uint64
+
+	// [0] = [WRITE] StakeAccount
+	// ··········· Stake account to be split
+	//
+	// [1] = [WRITE] NewStakeAccount
+	// ··········· Uninitialized stake account that will receive the split
+	//
+	// [2] = [SIGNER] StakeAuthorityAccount
+	// ··········· Stake authority
+	ag_solanago.AccountMetaSlice `bin:"-" borsh_skip:"true"`
+}
+
+// NewSplitInstructionBuilder creates a new `Split` instruction builder.
+func NewSplitInstructionBuilder() *Split {
+	nd := &Split{
+		AccountMetaSlice: make(ag_solanago.AccountMetaSlice, 3),
+	}
+	return nd
+}
+
+// Number of lamports to move to the new stake account.
+func (inst *Split) SetLamports(lamports uint64) *Split {
+	inst.Lamports = &lamports
+	return inst
+}
+
+// Stake account to be split
+func (inst *Split) SetStakeAccount(stakeAccount ag_solanago.PublicKey) *Split {
+	inst.AccountMetaSlice[0] = ag_solanago.Meta(stakeAccount).WRITE()
+	return inst
+}
+
+func (inst *Split) GetStakeAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[0]
+}
+
+// Uninitialized stake account that will receive the split
+func (inst *Split) SetNewStakeAccount(newStakeAccount ag_solanago.PublicKey) *Split {
+	inst.AccountMetaSlice[1] = ag_solanago.Meta(newStakeAccount).WRITE()
+	return inst
+}
+
+func (inst *Split) GetNewStakeAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[1]
+}
+
+// Stake authority
+func (inst *Split) SetStakeAuthorityAccount(stakeAuthorityAccount ag_solanago.PublicKey) *Split {
+	inst.AccountMetaSlice[2] = ag_solanago.Meta(stakeAuthorityAccount).SIGNER()
+	return inst
+}
+
+func (inst *Split) GetStakeAuthorityAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[2]
+}
+
+func (inst Split) Build() *Instruction {
+	return &Instruction{BaseVariant: ag_binary.BaseVariant{
+		Impl:   inst,
+		TypeID: ag_binary.TypeIDFromUint32(Instruction_Split, binary.LittleEndian),
+	}}
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (inst Split) ValidateAndBuild() (*Instruction, error) {
+	if err := inst.Validate(); err != nil {
+		return nil, err
+	}
+	return inst.Build(), nil
+}
+
+func (inst *Split) Validate() error {
+	// Check whether all (required) parameters are set:
+	{
+		if inst.Lamports == nil {
+			return errors.New("Lamports parameter is not set")
+		}
+	}
+
+	// Check whether all accounts are set:
+	for accIndex, acc := range inst.AccountMetaSlice {
+		if acc == nil {
+			return fmt.Errorf("ins.AccountMetaSlice[%v] is not set", accIndex)
+		}
+	}
+	return nil
+}
+
+func (inst *Split) EncodeToTree(parent ag_treeout.Branches) {
+	parent.Child(ag_format.Program(ProgramName, ProgramID)).
+		//
+		ParentFunc(func(programBranch ag_treeout.Branches) {
+			programBranch.Child(ag_format.Instruction("Split")).
+				//
+				ParentFunc(func(instructionBranch ag_treeout.Branches) {
+
+					// Parameters of the instruction:
+					instructionBranch.Child("Params").ParentFunc(func(paramsBranch ag_treeout.Branches) {
+						paramsBranch.Child(ag_format.Param("Lamports", *inst.Lamports))
+					})
+
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts").ParentFunc(func(accountsBranch ag_treeout.Branches) {
+						accountsBranch.Child(ag_format.Meta("         Stake", inst.AccountMetaSlice[0]))
+						accountsBranch.Child(ag_format.Meta("      NewStake", inst.AccountMetaSlice[1]))
+						accountsBranch.Child(ag_format.Meta("StakeAuthority", inst.AccountMetaSlice[2]))
+					})
+				})
+		})
+}
+
+func (inst Split) MarshalWithEncoder(encoder *ag_binary.Encoder) error {
+	// Serialize `Lamports` param:
+	{
+		err := encoder.Encode(*inst.Lamports)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (inst *Split) UnmarshalWithDecoder(decoder *ag_binary.Decoder) error {
+	// Deserialize `Lamports` param:
+	{
+		err := decoder.Decode(&inst.Lamports)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewSplitInstruction declares a new Split instruction with the provided parameters and accounts.
+func NewSplitInstruction(
+	// Parameters:
+	lamports uint64,
+	// Accounts:
+	stakeAccount ag_solanago.PublicKey,
+	newStakeAccount ag_solanago.PublicKey,
+	stakeAuthorityAccount ag_solanago.PublicKey) *Split {
+	return NewSplitInstructionBuilder().
+		SetLamports(lamports).
+		SetStakeAccount(stakeAccount).
+		SetNewStakeAccount(newStakeAccount).
+		SetStakeAuthorityAccount(stakeAuthorityAccount)
+}
@@ -0,0 +1,111 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stake
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_require "github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode_Withdraw(t *testing.T) {
+	fu := ag_gofuzz.New().NilChance(0)
+	for i := 0; i < 1; i++ {
+		t.Run("Withdraw"+strconv.Itoa(i), func(t *testing.T) {
+			{
+				params := new(Withdraw)
+				fu.Fuzz(params)
+				params.AccountMetaSlice = nil
+				buf := new(bytes.Buffer)
+				err := encodeT(*params, buf)
+				ag_require.NoError(t, err)
+				//
+				got := new(Withdraw)
+				err = decodeT(got, buf.Bytes())
+				got.AccountMetaSlice = nil
+				ag_require.NoError(t, err)
+				ag_require.Equal(t, params, got)
+			}
+		})
+	}
+}
+
+func TestNewWithdrawInstruction_Custodian(t *testing.T) {
+	stakeAccount := ag_solanago.NewWallet().PublicKey()
+	recipient := ag_solanago.NewWallet().PublicKey()
+	authority := ag_solanago.NewWallet().PublicKey()
+	custodian := ag_solanago.NewWallet().PublicKey()
+
+	// Without custodian:
+	{
+		inst, err := NewWithdrawInstruction(
+			ag_solanago.LAMPORTS_PER_SOL,
+			stakeAccount,
+			recipient,
+			ag_solanago.SysVarClockPubkey,
+			ag_solanago.SysVarStakeHistoryPubkey,
+			authority,
+		).ValidateAndBuild()
+		ag_require.NoError(t, err)
+		ag_require.Len(t, inst.Accounts(), 5)
+		withdraw := inst.Impl.(Withdraw)
+		ag_require.Nil(t, withdraw.GetCustodianAccount())
+	}
+	// With custodian, as required while the lockup is in force:
+	{
+		inst, err := NewWithdrawInstruction(
+			ag_solanago.LAMPORTS_PER_SOL,
+			stakeAccount,
+			recipient,
+			ag_solanago.SysVarClockPubkey,
+			ag_solanago.SysVarStakeHistoryPubkey,
+			authority,
+		).SetCustodianAccount(custodian).ValidateAndBuild()
+		ag_require.NoError(t, err)
+		accounts := inst.Accounts()
+		ag_require.Len(t, accounts, 6)
+		ag_require.Equal(t, ag_solanago.Meta(custodian).SIGNER(), accounts[5])
+
+		data, err := inst.Data()
+		ag_require.NoError(t, err)
+		decoded, err := DecodeInstruction(accounts, data)
+		ag_require.NoError(t, err)
+		withdraw := decoded.Impl.(*Withdraw)
+		ag_require.NoError(t, withdraw.Validate())
+		ag_require.Equal(t, custodian, withdraw.GetCustodianAccount().PublicKey)
+		ag_require.Equal(t, ag_solanago.LAMPORTS_PER_SOL, *withdraw.Lamports)
+	}
+	// The decoded instruction without custodian is valid too:
+	{
+		decoded, err := DecodeInstruction(
+			[]*ag_solanago.AccountMeta{
+				ag_solanago.Meta(stakeAccount).WRITE(),
+				ag_solanago.Meta(recipient).WRITE(),
+				ag_solanago.Meta(ag_solanago.SysVarClockPubkey),
+				ag_solanago.Meta(ag_solanago.SysVarStakeHistoryPubkey),
+				ag_solanago.Meta(authority).SIGNER(),
+			},
+			[]byte{4, 0, 0, 0, 0, 0xca, 0x9a, 0x3b, 0, 0, 0, 0},
+		)
+		ag_require.NoError(t, err)
+		withdraw := decoded.Impl.(*Withdraw)
+		ag_require.NoError(t, withdraw.Validate())
+		ag_require.Nil(t, withdraw.GetCustodianAccount())
+	}
+}
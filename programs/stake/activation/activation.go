@@ -0,0 +1,146 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package activation decodes stake accounts and the stake history sysvar,
+// and computes the warmup and cooldown of stake delegations
+// the same way the stake program does.
+// It imports neither the stake program package nor the rpc client,
+// so that both can rely on it.
+package activation
+
+import (
+	"math"
+)
+
+// WarmupCooldownRate is the rate at which stake is activated and deactivated
+// per epoch, as a fraction of the cluster's effective stake.
+const WarmupCooldownRate = 0.09
+
+// ClusterStake is the cluster-wide stake at an epoch, as recorded
+// in the stake history sysvar.
+type ClusterStake struct {
+	Effective    uint64
+	Activating   uint64
+	Deactivating uint64
+}
+
+// HistoryFunc returns the cluster stake at the provided epoch,
+// or false if the epoch is not in the stake history.
+type HistoryFunc func(epoch uint64) (ClusterStake, bool)
+
+// Progress returns the effective, activating and deactivating stake
+// of the delegation at the target epoch.
+// This mirrors Delegation::stake_activating_and_deactivating of the stake program.
+func Progress(delegation Delegation, targetEpoch uint64, history HistoryFunc) (effective, activating, deactivating uint64) {
+	effective, activating = effectiveAndActivating(delegation, targetEpoch, history)
+
+	if targetEpoch < delegation.DeactivationEpoch {
+		return effective, activating, 0
+	}
+	if targetEpoch == delegation.DeactivationEpoch {
+		return effective, 0, effective
+	}
+	prevEpoch := delegation.DeactivationEpoch
+	prevClusterStake, ok := history(prevEpoch)
+	if !ok {
+		return 0, 0, 0
+	}
+	current := effective
+	for {
+		currentEpoch := prevEpoch + 1
+		if prevClusterStake.Deactivating == 0 {
+			break
+		}
+		weight := float64(current) / float64(prevClusterStake.Deactivating)
+		newlyNotEffectiveClusterStake := float64(prevClusterStake.Effective) * WarmupCooldownRate
+		newlyNotEffective := maxUint64(uint64(weight*newlyNotEffectiveClusterStake), 1)
+
+		current = saturatingSub(current, newlyNotEffective)
+		if current == 0 || currentEpoch >= targetEpoch {
+			break
+		}
+		cluster, ok := history(currentEpoch)
+		if !ok {
+			break
+		}
+		prevEpoch, prevClusterStake = currentEpoch, cluster
+	}
+	return current, 0, current
+}
+
+func effectiveAndActivating(delegation Delegation, targetEpoch uint64, history HistoryFunc) (effective, activating uint64) {
+	switch {
+	case delegation.ActivationEpoch == math.MaxUint64:
+		// Bootstrap stake is fully effective from genesis.
+		return delegation.Stake, 0
+	case delegation.ActivationEpoch == delegation.DeactivationEpoch:
+		// Deactivated before ever becoming active.
+		return 0, 0
+	case targetEpoch == delegation.ActivationEpoch:
+		return 0, delegation.Stake
+	case targetEpoch < delegation.ActivationEpoch:
+		return 0, 0
+	}
+	prevEpoch := delegation.ActivationEpoch
+	prevClusterStake, ok := history(prevEpoch)
+	if !ok {
+		// No history (or too old): fully effective.
+		return delegation.Stake, 0
+	}
+	var current uint64
+	for {
+		currentEpoch := prevEpoch + 1
+		if prevClusterStake.Activating == 0 {
+			break
+		}
+		weight := float64(delegation.Stake-current) / float64(prevClusterStake.Activating)
+		newlyEffectiveClusterStake := float64(prevClusterStake.Effective) * WarmupCooldownRate
+		newlyEffective := maxUint64(uint64(weight*newlyEffectiveClusterStake), 1)
+
+		current += newlyEffective
+		if current >= delegation.Stake {
+			current = delegation.Stake
+			break
+		}
+		if currentEpoch >= targetEpoch || currentEpoch >= delegation.DeactivationEpoch {
+			break
+		}
+		cluster, ok := history(currentEpoch)
+		if !ok {
+			break
+		}
+		prevEpoch, prevClusterStake = currentEpoch, cluster
+	}
+	return current, delegation.Stake - current
+}
+
+// InactiveStake returns the part of the lamports of a stake account
+// that is neither effective stake nor rent-exempt reserve.
+func InactiveStake(lamports, effective, rentExemptReserve uint64) uint64 {
+	return saturatingSub(saturatingSub(lamports, effective), rentExemptReserve)
+}
+
+func saturatingSub(a, b uint64) uint64 {
+	if b > a {
+		return 0
+	}
+	return a - b
+}
+
+func maxUint64(a, b uint64) uint64 {
+	if a > b {
+		return a
+	}
+	return b
+}
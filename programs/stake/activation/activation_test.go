@@ -0,0 +1,77 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package activation
+
+import (
+	"math"
+	"testing"
+
+	ag_require "github.com/stretchr/testify/require"
+)
+
+func TestProgress(t *testing.T) {
+	history := map[uint64]ClusterStake{
+		100: {Effective: 100_000, Activating: 20_000},
+		101: {Effective: 104_500, Activating: 15_500},
+		200: {Effective: 100_000, Deactivating: 20_000},
+	}
+	historyFunc := func(epoch uint64) (ClusterStake, bool) {
+		cluster, ok := history[epoch]
+		return cluster, ok
+	}
+	progress := func(delegation Delegation, epoch uint64) []uint64 {
+		effective, activating, deactivating := Progress(delegation, epoch, historyFunc)
+		return []uint64{effective, activating, deactivating}
+	}
+
+	// Bootstrap stake is always fully effective.
+	ag_require.Equal(t, []uint64{10_000, 0, 0}, progress(Delegation{Stake: 10_000, ActivationEpoch: math.MaxUint64, DeactivationEpoch: math.MaxUint64}, 0))
+	// Deactivated in the same epoch it was activated.
+	ag_require.Equal(t, []uint64{0, 0, 0}, progress(Delegation{Stake: 10_000, ActivationEpoch: 100, DeactivationEpoch: 100}, 101))
+
+	activating := Delegation{Stake: 10_000, ActivationEpoch: 100, DeactivationEpoch: math.MaxUint64}
+	ag_require.Equal(t, []uint64{0, 0, 0}, progress(activating, 99))
+	ag_require.Equal(t, []uint64{0, 10_000, 0}, progress(activating, 100))
+	// 10/20 of the 9% of the cluster's effective stake.
+	ag_require.Equal(t, []uint64{4_500, 5_500, 0}, progress(activating, 101))
+
+	deactivating := Delegation{Stake: 10_000, ActivationEpoch: 50, DeactivationEpoch: 200}
+	ag_require.Equal(t, []uint64{10_000, 0, 10_000}, progress(deactivating, 200))
+	ag_require.Equal(t, []uint64{5_500, 0, 5_500}, progress(deactivating, 201))
+}
+
+func TestStakeHistory_ClusterStake(t *testing.T) {
+	history := &StakeHistory{
+		Entries: []StakeHistoryEntry{
+			{Epoch: 101, Effective: 3, Activating: 2, Deactivating: 1},
+			{Epoch: 100, Effective: 6, Activating: 5, Deactivating: 4},
+		},
+	}
+	cluster, ok := history.ClusterStake(100)
+	ag_require.True(t, ok)
+	ag_require.Equal(t, ClusterStake{Effective: 6, Activating: 5, Deactivating: 4}, cluster)
+	_, ok = history.ClusterStake(102)
+	ag_require.False(t, ok)
+
+	var empty *StakeHistory
+	_, ok = empty.ClusterStake(100)
+	ag_require.False(t, ok)
+}
+
+func TestInactiveStake(t *testing.T) {
+	ag_require.Equal(t, uint64(7_000), InactiveStake(10_000, 1_000, 2_000))
+	// Never below zero.
+	ag_require.Equal(t, uint64(0), InactiveStake(10_000, 9_000, 2_000))
+}
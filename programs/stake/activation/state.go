@@ -0,0 +1,173 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package activation
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+)
+
+// Authorized holds the authorities of a stake account.
+type Authorized struct {
+	Staker     ag_solanago.PublicKey
+	Withdrawer ag_solanago.PublicKey
+}
+
+// Lockup holds the lockup of a stake account; the stake
+// cannot be withdrawn before the timestamp or the epoch is reached,
+// unless the transaction is signed by the custodian.
+type Lockup struct {
+	UnixTimestamp int64
+	Epoch         uint64
+	Custodian     ag_solanago.PublicKey
+}
+
+// StakeStateType is the kind of state of a stake account.
+type StakeStateType uint32
+
+const (
+	StakeStateUninitialized StakeStateType = iota
+	StakeStateInitialized
+	StakeStateStake
+	StakeStateRewardsPool
+)
+
+// Meta holds the metadata of an initialized stake account.
+type Meta struct {
+	RentExemptReserve uint64
+	Authorized        Authorized
+	Lockup            Lockup
+}
+
+// Delegation is the delegation of a stake account to a vote account.
+type Delegation struct {
+	VoterPubkey       ag_solanago.PublicKey
+	Stake             uint64
+	ActivationEpoch   uint64
+	DeactivationEpoch uint64
+	// Deprecated: the cluster-wide rate is used instead.
+	WarmupCooldownRate float64
+}
+
+// Stake holds the delegation of a delegated stake account.
+type Stake struct {
+	Delegation      Delegation
+	CreditsObserved uint64
+}
+
+// StakeState is the decoded state of a stake account.
+type StakeState struct {
+	Type StakeStateType
+	// Set if Type is StakeStateInitialized or StakeStateStake.
+	Meta *Meta
+	// Set if Type is StakeStateStake.
+	Stake *Stake
+}
+
+// DecodeStakeState decodes the data of a stake account.
+func DecodeStakeState(data []byte) (*StakeState, error) {
+	decoder := ag_binary.NewBinDecoder(data)
+	typ, err := decoder.ReadUint32(binary.LittleEndian)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode stake state type: %w", err)
+	}
+	out := &StakeState{Type: StakeStateType(typ)}
+	switch out.Type {
+	case StakeStateUninitialized, StakeStateRewardsPool:
+		return out, nil
+	case StakeStateInitialized, StakeStateStake:
+		out.Meta = new(Meta)
+		if err := decoder.Decode(out.Meta); err != nil {
+			return nil, fmt.Errorf("unable to decode stake meta: %w", err)
+		}
+		if out.Type == StakeStateStake {
+			out.Stake = new(Stake)
+			if err := decoder.Decode(out.Stake); err != nil {
+				return nil, fmt.Errorf("unable to decode stake: %w", err)
+			}
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unknown stake state type: %d", typ)
+	}
+}
+
+// StakeHistoryEntry holds the cluster-wide stake at an epoch.
+type StakeHistoryEntry struct {
+	Epoch        uint64
+	Effective    uint64
+	Activating   uint64
+	Deactivating uint64
+}
+
+// StakeHistory is the content of the stake history sysvar
+// (SysvarStakeHistory1111111111111111111111111);
+// the entries are sorted by epoch, newest first.
+type StakeHistory struct {
+	Entries []StakeHistoryEntry
+}
+
+// Get returns the entry for the provided epoch, if present.
+func (h *StakeHistory) Get(epoch uint64) (*StakeHistoryEntry, bool) {
+	if h == nil {
+		return nil, false
+	}
+	index := sort.Search(len(h.Entries), func(i int) bool {
+		return h.Entries[i].Epoch <= epoch
+	})
+	if index < len(h.Entries) && h.Entries[index].Epoch == epoch {
+		return &h.Entries[index], true
+	}
+	return nil, false
+}
+
+// ClusterStake returns the cluster stake at the provided epoch, if present;
+// it can be passed to Progress as a HistoryFunc.
+func (h *StakeHistory) ClusterStake(epoch uint64) (ClusterStake, bool) {
+	entry, ok := h.Get(epoch)
+	if !ok {
+		return ClusterStake{}, false
+	}
+	return ClusterStake{
+		Effective:    entry.Effective,
+		Activating:   entry.Activating,
+		Deactivating: entry.Deactivating,
+	}, true
+}
+
+// DecodeStakeHistory decodes the data of the stake history sysvar.
+func DecodeStakeHistory(data []byte) (*StakeHistory, error) {
+	decoder := ag_binary.NewBinDecoder(data)
+	count, err := decoder.ReadUint64(binary.LittleEndian)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode stake history length: %w", err)
+	}
+	if count > uint64(decoder.Remaining()/32) {
+		return nil, fmt.Errorf("invalid stake history: %d entries declared, data holds at most %d", count, decoder.Remaining()/32)
+	}
+	out := &StakeHistory{
+		Entries: make([]StakeHistoryEntry, count),
+	}
+	for i := range out.Entries {
+		if err := decoder.Decode(&out.Entries[i]); err != nil {
+			return nil, fmt.Errorf("unable to decode stake history entry %d: %w", i, err)
+		}
+	}
+	return out, nil
+}
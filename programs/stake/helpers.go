@@ -0,0 +1,114 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stake
+
+import (
+	"errors"
+	"fmt"
+
+	ag_solanago "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+)
+
+const (
+	// StakeAccountSize is the size in bytes of a stake account.
+	StakeAccountSize = 200
+
+	// StakeAccountRentExemptReserve is the minimum balance in lamports
+	// for a stake account of StakeAccountSize bytes to be rent exempt.
+	StakeAccountRentExemptReserve uint64 = 2282880
+
+	// MinimumDelegation is the minimum amount of lamports that must
+	// remain delegated in a stake account.
+	// NOTE: the cluster value can be raised by a feature gate;
+	// use the getStakeMinimumDelegation RPC method to get the current one.
+	MinimumDelegation uint64 = 1
+)
+
+// ValidateSplit checks that splitting `lamports` off a stake account
+// holding `sourceLamports` leaves both accounts with at least
+// the rent-exempt reserve plus the minimum delegation.
+func ValidateSplit(sourceLamports uint64, lamports uint64) error {
+	min := StakeAccountRentExemptReserve + MinimumDelegation
+	if lamports < min {
+		return fmt.Errorf("split amount %d is below the minimum stake account balance of %d lamports", lamports, min)
+	}
+	if sourceLamports < lamports || sourceLamports-lamports < min {
+		return fmt.Errorf("splitting %d lamports off %d would leave the source stake account below the minimum balance of %d lamports", lamports, sourceLamports, min)
+	}
+	return nil
+}
+
+// NewSplitStake returns the instructions to split `lamports` off the `source` stake account
+// into `newAccount`: the system create-account that allocates the new stake account
+// (funded by the split itself, so the authority pays nothing), followed by the stake Split.
+// Both `newAccount` and `authority` must sign the transaction.
+func NewSplitStake(
+	source ag_solanago.PublicKey,
+	newAccount ag_solanago.PublicKey,
+	authority ag_solanago.PublicKey,
+	lamports uint64,
+) ([]ag_solanago.Instruction, error) {
+	if source.Equals(newAccount) {
+		return nil, errors.New("source and new stake account must be different")
+	}
+	min := StakeAccountRentExemptReserve + MinimumDelegation
+	if lamports < min {
+		return nil, fmt.Errorf("split amount %d is below the minimum stake account balance of %d lamports", lamports, min)
+	}
+	createAccount, err := system.NewCreateAccountInstruction(
+		0,
+		StakeAccountSize,
+		ProgramID,
+		authority,
+		newAccount,
+	).ValidateAndBuild()
+	if err != nil {
+		return nil, err
+	}
+	split, err := NewSplitInstruction(
+		lamports,
+		source,
+		newAccount,
+		authority,
+	).ValidateAndBuild()
+	if err != nil {
+		return nil, err
+	}
+	return []ag_solanago.Instruction{createAccount, split}, nil
+}
+
+// NewMergeStake returns the instructions to merge the `source` stake account into `dest`.
+// The source account is drained and closed.
+func NewMergeStake(
+	dest ag_solanago.PublicKey,
+	source ag_solanago.PublicKey,
+	authority ag_solanago.PublicKey,
+) ([]ag_solanago.Instruction, error) {
+	if dest.Equals(source) {
+		return nil, errors.New("cannot merge a stake account into itself")
+	}
+	merge, err := NewMergeInstruction(
+		dest,
+		source,
+		ag_solanago.SysVarClockPubkey,
+		ag_solanago.SysVarStakeHistoryPubkey,
+		authority,
+	).ValidateAndBuild()
+	if err != nil {
+		return nil, err
+	}
+	return []ag_solanago.Instruction{merge}, nil
+}
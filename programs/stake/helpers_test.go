@@ -0,0 +1,126 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stake
+
+import (
+	"testing"
+
+	ag_solanago "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	ag_require "github.com/stretchr/testify/require"
+)
+
+func TestNewSplitStake(t *testing.T) {
+	source := ag_solanago.NewWallet().PublicKey()
+	newAccount := ag_solanago.NewWallet().PublicKey()
+	authority := ag_solanago.NewWallet().PublicKey()
+	lamports := 5 * ag_solanago.LAMPORTS_PER_SOL
+
+	instructions, err := NewSplitStake(source, newAccount, authority, lamports)
+	ag_require.NoError(t, err)
+	ag_require.Len(t, instructions, 2)
+
+	{
+		ag_require.Equal(t, system.ProgramID, instructions[0].ProgramID())
+		data, err := instructions[0].Data()
+		ag_require.NoError(t, err)
+		decoded, err := system.DecodeInstruction(instructions[0].Accounts(), data)
+		ag_require.NoError(t, err)
+		create, ok := decoded.Impl.(*system.CreateAccount)
+		ag_require.True(t, ok)
+		ag_require.Equal(t, uint64(0), *create.Lamports)
+		ag_require.Equal(t, uint64(StakeAccountSize), *create.Space)
+		ag_require.Equal(t, ProgramID, *create.Owner)
+		ag_require.Equal(t, authority, create.GetFundingAccount().PublicKey)
+		ag_require.Equal(t, newAccount, create.GetNewAccount().PublicKey)
+		ag_require.True(t, create.GetNewAccount().IsSigner)
+	}
+	{
+		ag_require.Equal(t, ProgramID, instructions[1].ProgramID())
+		data, err := instructions[1].Data()
+		ag_require.NoError(t, err)
+		decoded, err := DecodeInstruction(instructions[1].Accounts(), data)
+		ag_require.NoError(t, err)
+		split, ok := decoded.Impl.(*Split)
+		ag_require.True(t, ok)
+		ag_require.Equal(t, lamports, *split.Lamports)
+		ag_require.Equal(t, source, split.GetStakeAccount().PublicKey)
+		ag_require.True(t, split.GetStakeAccount().IsWritable)
+		ag_require.Equal(t, newAccount, split.GetNewStakeAccount().PublicKey)
+		ag_require.True(t, split.GetNewStakeAccount().IsWritable)
+		ag_require.Equal(t, authority, split.GetStakeAuthorityAccount().PublicKey)
+		ag_require.True(t, split.GetStakeAuthorityAccount().IsSigner)
+	}
+}
+
+func TestNewSplitStake_BelowMinimum(t *testing.T) {
+	source := ag_solanago.NewWallet().PublicKey()
+	newAccount := ag_solanago.NewWallet().PublicKey()
+	authority := ag_solanago.NewWallet().PublicKey()
+
+	_, err := NewSplitStake(source, newAccount, authority, StakeAccountRentExemptReserve)
+	ag_require.Error(t, err)
+
+	_, err = NewSplitStake(source, source, authority, 5*ag_solanago.LAMPORTS_PER_SOL)
+	ag_require.Error(t, err)
+}
+
+func TestValidateSplit(t *testing.T) {
+	min := StakeAccountRentExemptReserve + MinimumDelegation
+
+	ag_require.NoError(t, ValidateSplit(2*min, min))
+	ag_require.Error(t, ValidateSplit(2*min, min-1))
+	ag_require.Error(t, ValidateSplit(2*min-1, min))
+	ag_require.Error(t, ValidateSplit(min, 2*min))
+}
+
+func TestNewMergeStake(t *testing.T) {
+	dest := ag_solanago.NewWallet().PublicKey()
+	source := ag_solanago.NewWallet().PublicKey()
+	authority := ag_solanago.NewWallet().PublicKey()
+
+	instructions, err := NewMergeStake(dest, source, authority)
+	ag_require.NoError(t, err)
+	ag_require.Len(t, instructions, 1)
+
+	data, err := instructions[0].Data()
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, []byte{7, 0, 0, 0}, data)
+
+	accounts := instructions[0].Accounts()
+	ag_require.Len(t, accounts, 5)
+	ag_require.Equal(t, dest, accounts[0].PublicKey)
+	ag_require.True(t, accounts[0].IsWritable)
+	ag_require.Equal(t, source, accounts[1].PublicKey)
+	ag_require.True(t, accounts[1].IsWritable)
+	ag_require.Equal(t, ag_solanago.SysVarClockPubkey, accounts[2].PublicKey)
+	ag_require.Equal(t, ag_solanago.SysVarStakeHistoryPubkey, accounts[3].PublicKey)
+	ag_require.Equal(t, authority, accounts[4].PublicKey)
+	ag_require.True(t, accounts[4].IsSigner)
+
+	_, err = NewMergeStake(dest, dest, authority)
+	ag_require.Error(t, err)
+}
+
+func TestSetLockup_OptionEncoding(t *testing.T) {
+	epoch := uint64(300)
+	data, err := NewSetLockupInstruction(
+		LockupArgs{Epoch: &epoch},
+		ag_solanago.NewWallet().PublicKey(),
+		ag_solanago.NewWallet().PublicKey(),
+	).Build().Data()
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, []byte{6, 0, 0, 0, 0, 1, 44, 1, 0, 0, 0, 0, 0, 0, 0}, data)
+}
@@ -0,0 +1,126 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stake
+
+import (
+	"fmt"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_config "github.com/gagliardetto/solana-go/programs/config"
+	ag_activation "github.com/gagliardetto/solana-go/programs/stake/activation"
+	ag_rpc "github.com/gagliardetto/solana-go/rpc"
+)
+
+// StakeStateType is the kind of state of a stake account.
+type StakeStateType = ag_activation.StakeStateType
+
+const (
+	StakeStateUninitialized = ag_activation.StakeStateUninitialized
+	StakeStateInitialized   = ag_activation.StakeStateInitialized
+	StakeStateStake         = ag_activation.StakeStateStake
+	StakeStateRewardsPool   = ag_activation.StakeStateRewardsPool
+)
+
+// Meta holds the metadata of an initialized stake account.
+type Meta = ag_activation.Meta
+
+// Delegation is the delegation of a stake account to a vote account.
+type Delegation = ag_activation.Delegation
+
+// Stake holds the delegation of a delegated stake account.
+type Stake = ag_activation.Stake
+
+// StakeState is the decoded state of a stake account.
+type StakeState = ag_activation.StakeState
+
+// DecodeStakeState decodes the data of a stake account.
+func DecodeStakeState(data []byte) (*StakeState, error) {
+	return ag_activation.DecodeStakeState(data)
+}
+
+// Clock is the content of the clock sysvar (SysvarC1ock11111111111111111111111111111111).
+type Clock struct {
+	Slot                uint64
+	EpochStartTimestamp int64
+	Epoch               uint64
+	LeaderScheduleEpoch uint64
+	UnixTimestamp       int64
+}
+
+// DecodeClock decodes the data of the clock sysvar.
+func DecodeClock(data []byte) (*Clock, error) {
+	out := new(Clock)
+	if err := ag_binary.NewBinDecoder(data).Decode(out); err != nil {
+		return nil, fmt.Errorf("unable to decode clock: %w", err)
+	}
+	return out, nil
+}
+
+// IsLockupInForce returns true if the lockup prevents withdrawing from
+// (or changing the withdraw authority of) the stake account at the time of the provided clock,
+// i.e. if either its timestamp or its epoch has not been reached yet
+// and the transaction is not signed by the lockup custodian.
+func IsLockupInForce(lockup Lockup, clock *Clock, custodianSigned bool) bool {
+	if custodianSigned {
+		return false
+	}
+	if clock == nil {
+		return true
+	}
+	return lockup.UnixTimestamp > clock.UnixTimestamp || lockup.Epoch > clock.Epoch
+}
+
+// StakeHistoryEntry holds the cluster-wide stake at an epoch.
+type StakeHistoryEntry = ag_activation.StakeHistoryEntry
+
+// StakeHistory is the content of the stake history sysvar
+// (SysvarStakeHistory1111111111111111111111111);
+// the entries are sorted by epoch, newest first.
+type StakeHistory = ag_activation.StakeHistory
+
+// DecodeStakeHistory decodes the data of the stake history sysvar.
+func DecodeStakeHistory(data []byte) (*StakeHistory, error) {
+	return ag_activation.DecodeStakeHistory(data)
+}
+
+// StakeConfig is the content of the stake config account
+// (StakeConfig11111111111111111111111111111111), which is
+// referenced by the DelegateStake instruction.
+type StakeConfig = ag_config.StakeConfig
+
+// DecodeStakeConfig decodes the data of the stake config account.
+// The data is only checked to have the layout of a stake config;
+// use config.IsStakeConfig to check the address of the account.
+func DecodeStakeConfig(data []byte) (*StakeConfig, error) {
+	return ag_config.DecodeStakeConfig(data)
+}
+
+// ActivationProgress returns the effective (active), activating and deactivating stake
+// of the provided stake account at the epoch of epochInfo, given the stake history.
+// This mirrors Delegation::stake_activating_and_deactivating of the stake program.
+func ActivationProgress(
+	stakeState *StakeState,
+	epochInfo *ag_rpc.GetEpochInfoResult,
+	stakeHistory *StakeHistory,
+) (active, activating, deactivating uint64) {
+	if stakeState == nil || stakeState.Stake == nil || epochInfo == nil {
+		return 0, 0, 0
+	}
+	return ag_activation.Progress(
+		stakeState.Stake.Delegation,
+		epochInfo.Epoch,
+		stakeHistory.ClusterStake,
+	)
+}
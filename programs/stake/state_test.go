@@ -0,0 +1,208 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stake
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_rpc "github.com/gagliardetto/solana-go/rpc"
+	ag_require "github.com/stretchr/testify/require"
+)
+
+func encodeStakeHistory(entries ...StakeHistoryEntry) []byte {
+	data := make([]byte, 8+32*len(entries))
+	binary.LittleEndian.PutUint64(data, uint64(len(entries)))
+	for i, entry := range entries {
+		offset := 8 + 32*i
+		binary.LittleEndian.PutUint64(data[offset:], entry.Epoch)
+		binary.LittleEndian.PutUint64(data[offset+8:], entry.Effective)
+		binary.LittleEndian.PutUint64(data[offset+16:], entry.Activating)
+		binary.LittleEndian.PutUint64(data[offset+24:], entry.Deactivating)
+	}
+	return data
+}
+
+func encodeDelegatedStake(voter ag_solanago.PublicKey, stake, activationEpoch, deactivationEpoch uint64) []byte {
+	data := make([]byte, StakeAccountSize)
+	binary.LittleEndian.PutUint32(data[0:], uint32(StakeStateStake))
+	binary.LittleEndian.PutUint64(data[4:], StakeAccountRentExemptReserve)
+	copy(data[124:], voter[:])
+	binary.LittleEndian.PutUint64(data[156:], stake)
+	binary.LittleEndian.PutUint64(data[164:], activationEpoch)
+	binary.LittleEndian.PutUint64(data[172:], deactivationEpoch)
+	binary.LittleEndian.PutUint64(data[180:], math.Float64bits(0.25))
+	binary.LittleEndian.PutUint64(data[188:], 42)
+	return data
+}
+
+func TestDecodeStakeState(t *testing.T) {
+	voter := ag_solanago.MustPublicKeyFromBase58("CertusDeBmqN8ZawdkxK5kFGMwBXdudvWHYwtNgNhvLu")
+	state, err := DecodeStakeState(encodeDelegatedStake(voter, 10*ag_solanago.LAMPORTS_PER_SOL, 100, math.MaxUint64))
+	ag_require.NoError(t, err)
+
+	ag_require.Equal(t, StakeStateStake, state.Type)
+	ag_require.Equal(t, StakeAccountRentExemptReserve, state.Meta.RentExemptReserve)
+	ag_require.Equal(t,
+		Stake{
+			Delegation: Delegation{
+				VoterPubkey:        voter,
+				Stake:              10 * ag_solanago.LAMPORTS_PER_SOL,
+				ActivationEpoch:    100,
+				DeactivationEpoch:  math.MaxUint64,
+				WarmupCooldownRate: 0.25,
+			},
+			CreditsObserved: 42,
+		},
+		*state.Stake,
+	)
+
+	{
+		data := make([]byte, StakeAccountSize)
+		binary.LittleEndian.PutUint32(data, uint32(StakeStateInitialized))
+		state, err := DecodeStakeState(data)
+		ag_require.NoError(t, err)
+		ag_require.NotNil(t, state.Meta)
+		ag_require.Nil(t, state.Stake)
+	}
+	{
+		_, err := DecodeStakeState([]byte{9, 0, 0, 0})
+		ag_require.Error(t, err)
+	}
+}
+
+func TestDecodeStakeHistory(t *testing.T) {
+	entries := []StakeHistoryEntry{
+		{Epoch: 101, Effective: 3, Activating: 2, Deactivating: 1},
+		{Epoch: 100, Effective: 6, Activating: 5, Deactivating: 4},
+	}
+	history, err := DecodeStakeHistory(encodeStakeHistory(entries...))
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, entries, history.Entries)
+
+	entry, ok := history.Get(100)
+	ag_require.True(t, ok)
+	ag_require.Equal(t, entries[1], *entry)
+	_, ok = history.Get(99)
+	ag_require.False(t, ok)
+
+	// The declared length exceeds the data.
+	data := encodeStakeHistory(entries...)
+	binary.LittleEndian.PutUint64(data, 3)
+	_, err = DecodeStakeHistory(data)
+	ag_require.Error(t, err)
+}
+
+func TestActivationProgress(t *testing.T) {
+	voter := ag_solanago.MustPublicKeyFromBase58("CertusDeBmqN8ZawdkxK5kFGMwBXdudvWHYwtNgNhvLu")
+
+	history, err := DecodeStakeHistory(encodeStakeHistory(
+		StakeHistoryEntry{Epoch: 200, Effective: 100 * ag_solanago.LAMPORTS_PER_SOL, Deactivating: 20 * ag_solanago.LAMPORTS_PER_SOL},
+		StakeHistoryEntry{Epoch: 101, Effective: 104500000000, Activating: 15500000000},
+		StakeHistoryEntry{Epoch: 100, Effective: 100 * ag_solanago.LAMPORTS_PER_SOL, Activating: 20 * ag_solanago.LAMPORTS_PER_SOL},
+	))
+	ag_require.NoError(t, err)
+
+	activatingState, err := DecodeStakeState(encodeDelegatedStake(voter, 10*ag_solanago.LAMPORTS_PER_SOL, 100, math.MaxUint64))
+	ag_require.NoError(t, err)
+
+	cases := []struct {
+		epoch        uint64
+		active       uint64
+		activating   uint64
+		deactivating uint64
+	}{
+		{99, 0, 0, 0},
+		{100, 0, 10 * ag_solanago.LAMPORTS_PER_SOL, 0},
+		// 10/20 of the 9% of the cluster's effective stake (100 SOL).
+		{101, 4500000000, 5500000000, 0},
+		// 5.5/15.5 of the 9% of the cluster's effective stake (104.5 SOL).
+		{102, 7837258064, 2162741936, 0},
+	}
+	for _, c := range cases {
+		active, activating, deactivating := ActivationProgress(activatingState, &ag_rpc.GetEpochInfoResult{Epoch: c.epoch}, history)
+		ag_require.Equal(t, c.active, active, "epoch %d", c.epoch)
+		ag_require.Equal(t, c.activating, activating, "epoch %d", c.epoch)
+		ag_require.Equal(t, c.deactivating, deactivating, "epoch %d", c.epoch)
+	}
+
+	// Fully active (no history for the activation epoch), deactivated at epoch 200.
+	deactivatingState, err := DecodeStakeState(encodeDelegatedStake(voter, 10*ag_solanago.LAMPORTS_PER_SOL, 50, 200))
+	ag_require.NoError(t, err)
+	{
+		active, activating, deactivating := ActivationProgress(deactivatingState, &ag_rpc.GetEpochInfoResult{Epoch: 200}, history)
+		ag_require.Equal(t, []uint64{10 * ag_solanago.LAMPORTS_PER_SOL, 0, 10 * ag_solanago.LAMPORTS_PER_SOL}, []uint64{active, activating, deactivating})
+	}
+	{
+		active, activating, deactivating := ActivationProgress(deactivatingState, &ag_rpc.GetEpochInfoResult{Epoch: 201}, history)
+		ag_require.Equal(t, []uint64{5500000000, 0, 5500000000}, []uint64{active, activating, deactivating})
+	}
+
+	// Not delegated.
+	active, activating, deactivating := ActivationProgress(&StakeState{Type: StakeStateInitialized, Meta: &Meta{}}, &ag_rpc.GetEpochInfoResult{Epoch: 101}, history)
+	ag_require.Equal(t, []uint64{0, 0, 0}, []uint64{active, activating, deactivating})
+}
+
+func TestIsLockupInForce(t *testing.T) {
+	clockData := make([]byte, 40)
+	binary.LittleEndian.PutUint64(clockData[0:], 250000000)   // slot
+	binary.LittleEndian.PutUint64(clockData[8:], 1690000000)  // epoch start timestamp
+	binary.LittleEndian.PutUint64(clockData[16:], 580)        // epoch
+	binary.LittleEndian.PutUint64(clockData[24:], 581)        // leader schedule epoch
+	binary.LittleEndian.PutUint64(clockData[32:], 1690100000) // unix timestamp
+	clock, err := DecodeClock(clockData)
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, Clock{
+		Slot:                250000000,
+		EpochStartTimestamp: 1690000000,
+		Epoch:               580,
+		LeaderScheduleEpoch: 581,
+		UnixTimestamp:       1690100000,
+	}, *clock)
+
+	custodian := ag_solanago.MustPublicKeyFromBase58("4Qkev8aNZcqFNSRhQzwyLMFSsi94jHqE8WNVTJzTP99F")
+
+	// Active lockups:
+	ag_require.True(t, IsLockupInForce(Lockup{UnixTimestamp: 1690100001, Custodian: custodian}, clock, false))
+	ag_require.True(t, IsLockupInForce(Lockup{Epoch: 581, Custodian: custodian}, clock, false))
+	// ... unless the custodian signed.
+	ag_require.False(t, IsLockupInForce(Lockup{UnixTimestamp: 1690100001, Epoch: 581, Custodian: custodian}, clock, true))
+
+	// Expired lockups:
+	ag_require.False(t, IsLockupInForce(Lockup{UnixTimestamp: 1690100000, Epoch: 580, Custodian: custodian}, clock, false))
+	ag_require.False(t, IsLockupInForce(Lockup{}, clock, false))
+}
+
+func TestDecodeStakeConfig(t *testing.T) {
+	// The stake config account (StakeConfig11111111111111111111111111111111), as returned by getAccountInfo:
+	// an empty list of config keys, followed by the config.
+	data, err := base64.StdEncoding.DecodeString("AAAAAAAAANA/DA==")
+	ag_require.NoError(t, err)
+
+	conf, err := DecodeStakeConfig(data)
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, 0.25, conf.WarmupCooldownRate)
+	ag_require.Equal(t, uint8(12), conf.SlashPenalty)
+
+	_, err = DecodeStakeConfig(data[:1])
+	ag_require.Error(t, err)
+
+	// A stake account is not a stake config account.
+	_, err = DecodeStakeConfig(encodeDelegatedStake(ag_solanago.MustPublicKeyFromBase58("CertusDeBmqN8ZawdkxK5kFGMwBXdudvWHYwtNgNhvLu"), 1, 0, 1))
+	ag_require.Error(t, err)
+}
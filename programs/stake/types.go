@@ -0,0 +1,133 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stake
+
+import (
+	"encoding/binary"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_activation "github.com/gagliardetto/solana-go/programs/stake/activation"
+)
+
+// ConfigID is the address of the stake config account.
+var ConfigID = ag_solanago.MustPublicKeyFromBase58("StakeConfig11111111111111111111111111111111")
+
+// Authorized holds the authorities of a stake account.
+type Authorized = ag_activation.Authorized
+
+// Lockup holds the lockup of a stake account; the stake
+// cannot be withdrawn before the timestamp or the epoch is reached,
+// unless the transaction is signed by the custodian.
+type Lockup = ag_activation.Lockup
+
+// StakeAuthorize is the kind of authority to change with an Authorize instruction.
+type StakeAuthorize uint32
+
+const (
+	StakeAuthorizeStaker StakeAuthorize = iota
+	StakeAuthorizeWithdrawer
+)
+
+// LockupArgs holds the lockup values to be set with a SetLockup instruction;
+// nil values are left unchanged.
+type LockupArgs struct {
+	UnixTimestamp *int64
+	Epoch         *uint64
+	Custodian     *ag_solanago.PublicKey
+}
+
+func (args LockupArgs) MarshalWithEncoder(encoder *ag_binary.Encoder) error {
+	// Serialize `UnixTimestamp` option:
+	{
+		if err := encoder.WriteBool(args.UnixTimestamp != nil); err != nil {
+			return err
+		}
+		if args.UnixTimestamp != nil {
+			if err := encoder.WriteInt64(*args.UnixTimestamp, binary.LittleEndian); err != nil {
+				return err
+			}
+		}
+	}
+	// Serialize `Epoch` option:
+	{
+		if err := encoder.WriteBool(args.Epoch != nil); err != nil {
+			return err
+		}
+		if args.Epoch != nil {
+			if err := encoder.WriteUint64(*args.Epoch, binary.LittleEndian); err != nil {
+				return err
+			}
+		}
+	}
+	// Serialize `Custodian` option:
+	{
+		if err := encoder.WriteBool(args.Custodian != nil); err != nil {
+			return err
+		}
+		if args.Custodian != nil {
+			if err := encoder.Encode(*args.Custodian); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (args *LockupArgs) UnmarshalWithDecoder(decoder *ag_binary.Decoder) error {
+	// Deserialize `UnixTimestamp` option:
+	{
+		ok, err := decoder.ReadBool()
+		if err != nil {
+			return err
+		}
+		if ok {
+			v, err := decoder.ReadInt64(binary.LittleEndian)
+			if err != nil {
+				return err
+			}
+			args.UnixTimestamp = &v
+		}
+	}
+	// Deserialize `Epoch` option:
+	{
+		ok, err := decoder.ReadBool()
+		if err != nil {
+			return err
+		}
+		if ok {
+			v, err := decoder.ReadUint64(binary.LittleEndian)
+			if err != nil {
+				return err
+			}
+			args.Epoch = &v
+		}
+	}
+	// Deserialize `Custodian` option:
+	{
+		ok, err := decoder.ReadBool()
+		if err != nil {
+			return err
+		}
+		if ok {
+			var v ag_solanago.PublicKey
+			if err := decoder.Decode(&v); err != nil {
+				return err
+			}
+			args.Custodian = &v
+		}
+	}
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
 	ag_require "github.com/stretchr/testify/require"
 )
 
@@ -44,3 +45,37 @@ func TestEncodeDecode_AdvanceNonceAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestNewAdvanceNonceAccountInstruction(t *testing.T) {
+	nonce := ag_solanago.NewWallet().PublicKey()
+	authority := ag_solanago.NewWallet().PublicKey()
+
+	inst, err := NewAdvanceNonceAccountInstruction(nonce, ag_solanago.SysVarRecentBlockHashesPubkey, authority).ValidateAndBuild()
+	ag_require.NoError(t, err)
+
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, []byte{0x04, 0x00, 0x00, 0x00}, data)
+
+	// The nonce account, the recent blockhashes sysvar, then the authority.
+	ag_require.Equal(t,
+		ag_solanago.AccountMetaSlice{
+			ag_solanago.Meta(nonce).WRITE(),
+			ag_solanago.Meta(ag_solanago.SysVarRecentBlockHashesPubkey),
+			ag_solanago.Meta(authority).SIGNER(),
+		},
+		ag_solanago.AccountMetaSlice(inst.Accounts()),
+	)
+
+	decoded, err := DecodeInstruction(inst.Accounts(), data)
+	ag_require.NoError(t, err)
+	ag_require.IsType(t, &AdvanceNonceAccount{}, decoded.Impl)
+
+	// The builder defaults the sysvar.
+	built, err := NewAdvanceNonceAccountInstructionBuilder().
+		SetNonceAccount(nonce).
+		SetNonceAuthorityAccount(authority).
+		ValidateAndBuild()
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, inst.Accounts(), built.Accounts())
+}
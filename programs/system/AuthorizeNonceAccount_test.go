@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
 	ag_require "github.com/stretchr/testify/require"
 )
 
@@ -44,3 +45,28 @@ func TestEncodeDecode_AuthorizeNonceAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestNewAuthorizeNonceAccountInstruction(t *testing.T) {
+	nonce := ag_solanago.NewWallet().PublicKey()
+	authority := ag_solanago.NewWallet().PublicKey()
+	newAuthority := ag_solanago.NewWallet().PublicKey()
+
+	inst, err := NewAuthorizeNonceAccountInstruction(newAuthority, nonce, authority).ValidateAndBuild()
+	ag_require.NoError(t, err)
+
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, append([]byte{0x07, 0x00, 0x00, 0x00}, newAuthority[:]...), data)
+
+	ag_require.Equal(t,
+		ag_solanago.AccountMetaSlice{
+			ag_solanago.Meta(nonce).WRITE(),
+			ag_solanago.Meta(authority).SIGNER(),
+		},
+		ag_solanago.AccountMetaSlice(inst.Accounts()),
+	)
+
+	decoded, err := DecodeInstruction(inst.Accounts(), data)
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, newAuthority, *decoded.Impl.(*AuthorizeNonceAccount).Authorized)
+}
@@ -15,6 +15,7 @@
 package system
 
 import (
+	"context"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -220,3 +221,30 @@ func NewCreateAccountInstruction(
 		SetFundingAccount(fundingAccount).
 		SetNewAccount(newAccount)
 }
+
+// MinimumBalanceForRentExemptionFunc returns the minimum balance
+// for an account of dataSize bytes to be rent exempt, e.g.:
+//
+//	func(ctx context.Context, dataSize uint64) (uint64, error) {
+//		return rpcClient.GetMinimumBalanceForRentExemption(ctx, dataSize, rpc.CommitmentFinalized)
+//	}
+type MinimumBalanceForRentExemptionFunc func(ctx context.Context, dataSize uint64) (uint64, error)
+
+// NewCreateAccountRentExempt declares a new CreateAccount instruction
+// funding the new account with the minimum balance for `space` bytes to be rent exempt,
+// as returned by getMinimumBalance.
+func NewCreateAccountRentExempt(
+	ctx context.Context,
+	getMinimumBalance MinimumBalanceForRentExemptionFunc,
+	// Accounts:
+	from ag_solanago.PublicKey,
+	newAccount ag_solanago.PublicKey,
+	// Parameters:
+	space uint64,
+	owner ag_solanago.PublicKey) (*CreateAccount, error) {
+	lamports, err := getMinimumBalance(ctx, space)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get minimum balance for rent exemption: %w", err)
+	}
+	return NewCreateAccountInstruction(lamports, space, owner, from, newAccount), nil
+}
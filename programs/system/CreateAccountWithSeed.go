@@ -172,6 +172,17 @@ func (inst *CreateAccountWithSeed) Validate() error {
 			return fmt.Errorf("CreatedAccount is not set")
 		}
 	}
+
+	// Check that the created account is the one derived from base, seed and owner:
+	{
+		expected, err := ag_solanago.CreateWithSeed(*inst.Base, *inst.Seed, *inst.Owner)
+		if err != nil {
+			return fmt.Errorf("invalid seed: %w", err)
+		}
+		if !inst.AccountMetaSlice[1].PublicKey.Equals(expected) {
+			return fmt.Errorf("CreatedAccount %s does not match the address derived from base, seed and owner: %s", inst.AccountMetaSlice[1].PublicKey, expected)
+		}
+	}
 	return nil
 }
 
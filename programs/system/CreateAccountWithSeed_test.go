@@ -17,6 +17,7 @@ package system
 import (
 	"bytes"
 	"strconv"
+	"strings"
 	"testing"
 
 	bin "github.com/gagliardetto/binary"
@@ -94,3 +95,40 @@ func TestEncDec(t *testing.T) {
 		}
 	}
 }
+
+func TestNewCreateAccountWithSeedInstruction(t *testing.T) {
+	base := solana.NewWallet().PublicKey()
+	owner := solana.MustPublicKeyFromBase58("4sCcZNQR8vfWckyi5L9KdptdaiLxdiMjVgKQay7HxzmK")
+	// A seed with multi-byte UTF-8 characters: its length prefix counts bytes.
+	seed := "vault-é世"
+
+	created, err := solana.CreateWithSeed(base, seed, owner)
+	ag_require.NoError(t, err)
+
+	inst, err := NewCreateAccountWithSeedInstruction(base, seed, 918720, 4, owner, base, created, base).ValidateAndBuild()
+	ag_require.NoError(t, err)
+
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	// Instruction index, base, then the u64 length of the seed in bytes and the seed.
+	ag_require.Equal(t, []byte{3, 0, 0, 0}, data[:4])
+	ag_require.Equal(t, base[:], data[4:36])
+	ag_require.Equal(t, []byte{11, 0, 0, 0, 0, 0, 0, 0}, data[36:44])
+	ag_require.Equal(t, []byte(seed), data[44:55])
+
+	decoded, err := DecodeInstruction(inst.Accounts(), data)
+	ag_require.NoError(t, err)
+	got := decoded.Impl.(*CreateAccountWithSeed)
+	ag_require.Equal(t, seed, *got.Seed)
+	ag_require.Equal(t, owner, *got.Owner)
+	ag_require.Equal(t, uint64(918720), *got.Lamports)
+	ag_require.Equal(t, uint64(4), *got.Space)
+
+	// The created account must be derived from base, seed and owner.
+	_, err = NewCreateAccountWithSeedInstruction(base, seed, 918720, 4, owner, base, solana.NewWallet().PublicKey(), base).ValidateAndBuild()
+	ag_require.Error(t, err)
+	ag_require.Contains(t, err.Error(), "does not match the address derived from base, seed and owner")
+
+	_, err = NewCreateAccountWithSeedInstruction(base, strings.Repeat("a", solana.MaxSeedLength+1), 918720, 4, owner, base, created, base).ValidateAndBuild()
+	ag_require.Error(t, err)
+}
@@ -16,10 +16,13 @@ package system
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"strconv"
 	"testing"
 
 	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
 	ag_require "github.com/stretchr/testify/require"
 )
 
@@ -44,3 +47,40 @@ func TestEncodeDecode_CreateAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestNewCreateAccountRentExempt(t *testing.T) {
+	from := ag_solanago.NewWallet().PublicKey()
+	newAccount := ag_solanago.NewWallet().PublicKey()
+
+	inst, err := NewCreateAccountRentExempt(
+		context.Background(),
+		func(ctx context.Context, dataSize uint64) (uint64, error) {
+			ag_require.Equal(t, uint64(165), dataSize)
+			return 2039280, nil
+		},
+		from,
+		newAccount,
+		165,
+		ag_solanago.TokenProgramID,
+	)
+	ag_require.NoError(t, err)
+	ag_require.NoError(t, inst.Validate())
+
+	ag_require.Equal(t, uint64(2039280), *inst.Lamports)
+	ag_require.Equal(t, uint64(165), *inst.Space)
+	ag_require.Equal(t, ag_solanago.TokenProgramID, *inst.Owner)
+	ag_require.Equal(t, ag_solanago.Meta(from).WRITE().SIGNER(), inst.GetFundingAccount())
+	ag_require.Equal(t, ag_solanago.Meta(newAccount).WRITE().SIGNER(), inst.GetNewAccount())
+
+	_, err = NewCreateAccountRentExempt(
+		context.Background(),
+		func(ctx context.Context, dataSize uint64) (uint64, error) {
+			return 0, errors.New("connection refused")
+		},
+		from,
+		newAccount,
+		165,
+		ag_solanago.TokenProgramID,
+	)
+	ag_require.Error(t, err)
+}
@@ -182,3 +182,31 @@ func NewInitializeNonceAccountInstruction(
 		SetSysVarRecentBlockHashesPubkeyAccount(SysVarRecentBlockHashesPubkey).
 		SetSysVarRentPubkeyAccount(SysVarRentPubkey)
 }
+
+// NewCreateNonceAccount returns the instructions to create a durable nonce account
+// owned by the system program, funded by the payer with the provided rent-exempt balance
+// (see `GetMinimumBalanceForRentExemption` with `NonceAccountSize`),
+// and to initialize it with the provided nonce authority.
+// The nonceAccount must sign the transaction.
+func NewCreateNonceAccount(
+	payer ag_solanago.PublicKey,
+	nonceAccount ag_solanago.PublicKey,
+	nonceAuthority ag_solanago.PublicKey,
+	rentExempt uint64,
+) []ag_solanago.Instruction {
+	return []ag_solanago.Instruction{
+		NewCreateAccountInstruction(
+			rentExempt,
+			NonceAccountSize,
+			ag_solanago.SystemProgramID,
+			payer,
+			nonceAccount,
+		).Build(),
+		NewInitializeNonceAccountInstruction(
+			nonceAuthority,
+			nonceAccount,
+			ag_solanago.SysVarRecentBlockHashesPubkey,
+			ag_solanago.SysVarRentPubkey,
+		).Build(),
+	}
+}
@@ -19,7 +19,9 @@ import (
 	"strconv"
 	"testing"
 
+	ag_binary "github.com/gagliardetto/binary"
 	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
 	ag_require "github.com/stretchr/testify/require"
 )
 
@@ -44,3 +46,72 @@ func TestEncodeDecode_InitializeNonceAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestNewInitializeNonceAccountInstruction(t *testing.T) {
+	nonce := ag_solanago.NewWallet().PublicKey()
+	authority := ag_solanago.NewWallet().PublicKey()
+
+	inst, err := NewInitializeNonceAccountInstruction(
+		authority,
+		nonce,
+		ag_solanago.SysVarRecentBlockHashesPubkey,
+		ag_solanago.SysVarRentPubkey,
+	).ValidateAndBuild()
+	ag_require.NoError(t, err)
+
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, append([]byte{0x06, 0x00, 0x00, 0x00}, authority[:]...), data)
+
+	ag_require.Equal(t,
+		ag_solanago.AccountMetaSlice{
+			ag_solanago.Meta(nonce).WRITE(),
+			ag_solanago.Meta(ag_solanago.SysVarRecentBlockHashesPubkey),
+			ag_solanago.Meta(ag_solanago.SysVarRentPubkey),
+		},
+		ag_solanago.AccountMetaSlice(inst.Accounts()),
+	)
+
+	decoded, err := DecodeInstruction(inst.Accounts(), data)
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, authority, *decoded.Impl.(*InitializeNonceAccount).Authorized)
+}
+
+func TestNewCreateNonceAccount(t *testing.T) {
+	payer := ag_solanago.MustPublicKeyFromBase58("7xLk17EQQ5KLDLDe44wCmupJKJjTGd8hs3eSVVhCx932")
+	nonceAccount := ag_solanago.MustPublicKeyFromBase58("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM")
+	authority := ag_solanago.MustPublicKeyFromBase58("4Qkev8aNZcqFNSRhQzwyLMFSsi94jHqE8WNVTJzTP99F")
+
+	instructions := NewCreateNonceAccount(payer, nonceAccount, authority, 1447680)
+	ag_require.Len(t, instructions, 2)
+
+	decode := func(inst ag_solanago.Instruction) interface{} {
+		data, err := inst.Data()
+		ag_require.NoError(t, err)
+		decoded, err := DecodeInstruction(inst.Accounts(), data)
+		ag_require.NoError(t, err)
+		return decoded.Impl
+	}
+
+	// The account must be created first:
+	create, ok := decode(instructions[0]).(*CreateAccount)
+	ag_require.True(t, ok)
+	ag_require.Equal(t, uint64(1447680), *create.Lamports)
+	ag_require.Equal(t, uint64(NonceAccountSize), *create.Space)
+	ag_require.Equal(t, ag_solanago.SystemProgramID, *create.Owner)
+	ag_require.Equal(t, payer, create.GetFundingAccount().PublicKey)
+	ag_require.Equal(t, nonceAccount, create.GetNewAccount().PublicKey)
+	ag_require.True(t, create.GetNewAccount().IsSigner)
+
+	initialize, ok := decode(instructions[1]).(*InitializeNonceAccount)
+	ag_require.True(t, ok)
+	ag_require.Equal(t, authority, *initialize.Authorized)
+	ag_require.Equal(t, nonceAccount, initialize.GetNonceAccount().PublicKey)
+	ag_require.Equal(t, ag_solanago.SysVarRecentBlockHashesPubkey, initialize.GetSysVarRecentBlockHashesPubkeyAccount().PublicKey)
+	ag_require.Equal(t, ag_solanago.SysVarRentPubkey, initialize.GetSysVarRentPubkeyAccount().PublicKey)
+
+	// NonceAccountSize matches the encoded nonce account.
+	buf := new(bytes.Buffer)
+	ag_require.NoError(t, ag_binary.NewBinEncoder(buf).Encode(NonceAccount{}))
+	ag_require.Equal(t, NonceAccountSize, buf.Len())
+}
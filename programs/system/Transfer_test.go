@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
 	ag_require "github.com/stretchr/testify/require"
 )
 
@@ -44,3 +45,42 @@ func TestEncodeDecode_Transfer(t *testing.T) {
 		})
 	}
 }
+
+func TestNewTransferInstruction(t *testing.T) {
+	from := ag_solanago.NewWallet().PublicKey()
+	to := ag_solanago.NewWallet().PublicKey()
+
+	inst, err := NewTransferInstruction(1000000000, from, to).ValidateAndBuild()
+	ag_require.NoError(t, err)
+
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	// The u32 instruction index (2), then the u64 lamports.
+	ag_require.Equal(t,
+		[]byte{
+			0x02, 0x00, 0x00, 0x00,
+			0x00, 0xca, 0x9a, 0x3b, 0x00, 0x00, 0x00, 0x00,
+		},
+		data,
+	)
+
+	ag_require.Equal(t,
+		ag_solanago.AccountMetaSlice{
+			ag_solanago.Meta(from).WRITE().SIGNER(),
+			ag_solanago.Meta(to).WRITE(),
+		},
+		ag_solanago.AccountMetaSlice(inst.Accounts()),
+	)
+
+	decoded, err := DecodeInstruction(inst.Accounts(), data)
+	ag_require.NoError(t, err)
+	got := decoded.Impl.(*Transfer)
+	ag_require.Equal(t, uint64(1000000000), *got.Lamports)
+	ag_require.Equal(t, to, got.GetRecipientAccount().PublicKey)
+
+	_, err = NewTransferInstructionBuilder().
+		SetFundingAccount(from).
+		SetRecipientAccount(to).
+		ValidateAndBuild()
+	ag_require.EqualError(t, err, "Lamports parameter is not set")
+}
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
 	ag_require "github.com/stretchr/testify/require"
 )
 
@@ -44,3 +45,44 @@ func TestEncodeDecode_WithdrawNonceAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestNewWithdrawNonceAccountInstruction(t *testing.T) {
+	nonce := ag_solanago.NewWallet().PublicKey()
+	recipient := ag_solanago.NewWallet().PublicKey()
+	authority := ag_solanago.NewWallet().PublicKey()
+
+	inst, err := NewWithdrawNonceAccountInstruction(
+		1000000000,
+		nonce,
+		recipient,
+		ag_solanago.SysVarRecentBlockHashesPubkey,
+		ag_solanago.SysVarRentPubkey,
+		authority,
+	).ValidateAndBuild()
+	ag_require.NoError(t, err)
+
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	ag_require.Equal(t,
+		[]byte{
+			0x05, 0x00, 0x00, 0x00,
+			0x00, 0xca, 0x9a, 0x3b, 0x00, 0x00, 0x00, 0x00,
+		},
+		data,
+	)
+
+	ag_require.Equal(t,
+		ag_solanago.AccountMetaSlice{
+			ag_solanago.Meta(nonce).WRITE(),
+			ag_solanago.Meta(recipient).WRITE(),
+			ag_solanago.Meta(ag_solanago.SysVarRecentBlockHashesPubkey),
+			ag_solanago.Meta(ag_solanago.SysVarRentPubkey),
+			ag_solanago.Meta(authority).SIGNER(),
+		},
+		ag_solanago.AccountMetaSlice(inst.Accounts()),
+	)
+
+	decoded, err := DecodeInstruction(inst.Accounts(), data)
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, uint64(1000000000), *decoded.Impl.(*WithdrawNonceAccount).Lamports)
+}
@@ -16,11 +16,15 @@ package system
 
 import (
 	"encoding/binary"
+	"fmt"
 
 	bin "github.com/gagliardetto/binary"
 	"github.com/gagliardetto/solana-go"
 )
 
+// NonceAccountSize is the size of the data of a nonce account, in bytes.
+const NonceAccountSize = 80
+
 type NonceAccount struct {
 	Version          uint32
 	State            uint32
@@ -91,3 +95,47 @@ func (obj *FeeCalculator) UnmarshalWithDecoder(decoder *bin.Decoder) (err error)
 	obj.LamportsPerSignature, err = decoder.ReadUint64(binary.LittleEndian)
 	return err
 }
+
+// MaxRecentBlockhashes is the maximum number of entries
+// of the RecentBlockhashes sysvar.
+const MaxRecentBlockhashes = 150
+
+// RecentBlockhashesAccountSize is the size of the data
+// of the RecentBlockhashes sysvar account, in bytes:
+// the entries count followed by MaxRecentBlockhashes entries.
+const RecentBlockhashesAccountSize = 8 + MaxRecentBlockhashes*(32+8)
+
+// BlockhashEntry is an entry of the RecentBlockhashes sysvar.
+type BlockhashEntry struct {
+	Blockhash     solana.Hash
+	FeeCalculator FeeCalculator
+}
+
+// DecodeRecentBlockhashes decodes the data of the RecentBlockhashes sysvar account
+// (SysVarRecentBlockHashesPubkey).
+// The entries are ordered by descending block height (most recent first).
+func DecodeRecentBlockhashes(data []byte) ([]BlockhashEntry, error) {
+	decoder := bin.NewBinDecoder(data)
+	count, err := decoder.ReadUint64(binary.LittleEndian)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode recent blockhashes length: %w", err)
+	}
+	if count > MaxRecentBlockhashes {
+		return nil, fmt.Errorf("invalid recent blockhashes: %d entries declared, max is %d", count, MaxRecentBlockhashes)
+	}
+	if count > uint64(decoder.Remaining()/40) {
+		return nil, fmt.Errorf("invalid recent blockhashes: %d entries declared, data holds at most %d", count, decoder.Remaining()/40)
+	}
+	out := make([]BlockhashEntry, count)
+	for i := range out {
+		buf, err := decoder.ReadNBytes(32)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode recent blockhash %d: %w", i, err)
+		}
+		copy(out[i].Blockhash[:], buf)
+		if err := out[i].FeeCalculator.UnmarshalWithDecoder(decoder); err != nil {
+			return nil, fmt.Errorf("unable to decode fee calculator %d: %w", i, err)
+		}
+	}
+	return out, nil
+}
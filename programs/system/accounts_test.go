@@ -15,7 +15,9 @@
 package system
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/binary"
 	"testing"
 
 	bin "github.com/gagliardetto/binary"
@@ -42,3 +44,40 @@ func TestDecode(t *testing.T) {
 	assert.Equal(t, solana.MustPublicKeyFromBase58("8ksS6xXd7vzNrpZfBTf9gJ87Bma5AjnQ9baEcT7xH5QE"), acc.Nonce)
 	assert.Equal(t, uint64(5000), acc.FeeCalculator.LamportsPerSignature)
 }
+
+func TestDecodeRecentBlockhashes(t *testing.T) {
+	// The sysvar account is allocated for MaxRecentBlockhashes entries.
+	data := make([]byte, RecentBlockhashesAccountSize)
+	binary.LittleEndian.PutUint64(data, MaxRecentBlockhashes)
+	for i := 0; i < MaxRecentBlockhashes; i++ {
+		hash := sha256.Sum256([]byte{byte(i)})
+		copy(data[8+i*40:], hash[:])
+		binary.LittleEndian.PutUint64(data[8+i*40+32:], 5000)
+	}
+
+	entries, err := DecodeRecentBlockhashes(data)
+	assert.NoError(t, err)
+	assert.Len(t, entries, MaxRecentBlockhashes)
+	assert.Equal(t,
+		BlockhashEntry{
+			Blockhash:     solana.Hash(sha256.Sum256([]byte{0})),
+			FeeCalculator: FeeCalculator{LamportsPerSignature: 5000},
+		},
+		entries[0],
+	)
+	assert.Equal(t, solana.Hash(sha256.Sum256([]byte{MaxRecentBlockhashes - 1})), entries[MaxRecentBlockhashes-1].Blockhash)
+
+	// Right after genesis, fewer entries are set and the rest is zeroed.
+	binary.LittleEndian.PutUint64(data, 2)
+	entries, err = DecodeRecentBlockhashes(data)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	binary.LittleEndian.PutUint64(data, MaxRecentBlockhashes+1)
+	_, err = DecodeRecentBlockhashes(data)
+	assert.Error(t, err)
+
+	binary.LittleEndian.PutUint64(data, 2)
+	_, err = DecodeRecentBlockhashes(data[:8+40])
+	assert.Error(t, err)
+}
@@ -0,0 +1,58 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package system
+
+import (
+	"errors"
+	"fmt"
+
+	ag_solanago "github.com/gagliardetto/solana-go"
+)
+
+// TransferTarget is a recipient of a batch transfer.
+type TransferTarget struct {
+	To       ag_solanago.PublicKey
+	Lamports uint64
+}
+
+// NewBatchTransfer returns one Transfer instruction from `from` to each of the recipients,
+// in order. Use ag_solanago.SplitInstructions to pack them into transactions.
+func NewBatchTransfer(
+	from ag_solanago.PublicKey,
+	recipients []TransferTarget,
+) ([]ag_solanago.Instruction, error) {
+	if len(recipients) == 0 {
+		return nil, errors.New("no recipients")
+	}
+	instructions := make([]ag_solanago.Instruction, 0, len(recipients))
+	for i, recipient := range recipients {
+		if recipient.To.Equals(from) {
+			return nil, fmt.Errorf("recipient %d: cannot transfer to the sender %s", i, from)
+		}
+		if recipient.Lamports == 0 {
+			return nil, fmt.Errorf("recipient %d: cannot transfer zero lamports to %s", i, recipient.To)
+		}
+		inst, err := NewTransferInstruction(
+			recipient.Lamports,
+			from,
+			recipient.To,
+		).ValidateAndBuild()
+		if err != nil {
+			return nil, fmt.Errorf("recipient %d: %w", i, err)
+		}
+		instructions = append(instructions, inst)
+	}
+	return instructions, nil
+}
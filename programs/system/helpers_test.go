@@ -0,0 +1,62 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package system
+
+import (
+	"testing"
+
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_require "github.com/stretchr/testify/require"
+)
+
+func TestNewBatchTransfer(t *testing.T) {
+	from := ag_solanago.NewWallet().PublicKey()
+	recipients := []TransferTarget{
+		{To: ag_solanago.NewWallet().PublicKey(), Lamports: 1},
+		{To: ag_solanago.NewWallet().PublicKey(), Lamports: ag_solanago.LAMPORTS_PER_SOL},
+		{To: ag_solanago.NewWallet().PublicKey(), Lamports: 42},
+	}
+
+	instructions, err := NewBatchTransfer(from, recipients)
+	ag_require.NoError(t, err)
+	ag_require.Len(t, instructions, len(recipients))
+
+	for i, inst := range instructions {
+		ag_require.Equal(t, ProgramID, inst.ProgramID())
+		data, err := inst.Data()
+		ag_require.NoError(t, err)
+		decoded, err := DecodeInstruction(inst.Accounts(), data)
+		ag_require.NoError(t, err)
+		transfer, ok := decoded.Impl.(*Transfer)
+		ag_require.True(t, ok)
+		ag_require.Equal(t, recipients[i].Lamports, *transfer.Lamports)
+		ag_require.Equal(t, from, transfer.GetFundingAccount().PublicKey)
+		ag_require.True(t, transfer.GetFundingAccount().IsSigner)
+		ag_require.Equal(t, recipients[i].To, transfer.GetRecipientAccount().PublicKey)
+	}
+
+	t.Run("sender as recipient", func(t *testing.T) {
+		_, err := NewBatchTransfer(from, []TransferTarget{recipients[0], {To: from, Lamports: 1}})
+		ag_require.EqualError(t, err, "recipient 1: cannot transfer to the sender "+from.String())
+	})
+	t.Run("zero amount", func(t *testing.T) {
+		_, err := NewBatchTransfer(from, []TransferTarget{{To: recipients[0].To}})
+		ag_require.EqualError(t, err, "recipient 0: cannot transfer zero lamports to "+recipients[0].To.String())
+	})
+	t.Run("no recipients", func(t *testing.T) {
+		_, err := NewBatchTransfer(from, nil)
+		ag_require.EqualError(t, err, "no recipients")
+	})
+}
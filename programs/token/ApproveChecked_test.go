@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
 	ag_require "github.com/stretchr/testify/require"
 )
 
@@ -46,3 +47,48 @@ func TestEncodeDecode_ApproveChecked(t *testing.T) {
 		})
 	}
 }
+
+func TestNewApproveCheckedInstruction(t *testing.T) {
+	source := ag_solanago.NewWallet().PublicKey()
+	mint := ag_solanago.NewWallet().PublicKey()
+	delegate := ag_solanago.NewWallet().PublicKey()
+	owner := ag_solanago.NewWallet().PublicKey()
+	signer1 := ag_solanago.NewWallet().PublicKey()
+	signer2 := ag_solanago.NewWallet().PublicKey()
+
+	inst, err := NewApproveCheckedInstruction(1000, 6, source, mint, delegate, owner, []ag_solanago.PublicKey{signer1, signer2}).ValidateAndBuild()
+	ag_require.NoError(t, err)
+
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, []byte{Instruction_ApproveChecked, 0xe8, 0x03, 0, 0, 0, 0, 0, 0, 6}, data)
+
+	ag_require.Equal(t,
+		ag_solanago.AccountMetaSlice{
+			ag_solanago.Meta(source).WRITE(),
+			ag_solanago.Meta(mint),
+			ag_solanago.Meta(delegate),
+			ag_solanago.Meta(owner),
+			ag_solanago.Meta(signer1).SIGNER(),
+			ag_solanago.Meta(signer2).SIGNER(),
+		},
+		ag_solanago.AccountMetaSlice(inst.Accounts()),
+	)
+
+	// The signers start after the 4 fixed accounts.
+	decoded, err := DecodeInstruction(inst.Accounts(), data)
+	ag_require.NoError(t, err)
+	got := decoded.Impl.(*ApproveChecked)
+	ag_require.Equal(t, uint64(1000), *got.Amount)
+	ag_require.Equal(t, uint8(6), *got.Decimals)
+	ag_require.Equal(t, mint, got.GetMintAccount().PublicKey)
+	ag_require.Equal(t, delegate, got.GetDelegateAccount().PublicKey)
+	ag_require.Equal(t, owner, got.GetOwnerAccount().PublicKey)
+	ag_require.Equal(t,
+		ag_solanago.AccountMetaSlice{
+			ag_solanago.Meta(signer1).SIGNER(),
+			ag_solanago.Meta(signer2).SIGNER(),
+		},
+		got.Signers,
+	)
+}
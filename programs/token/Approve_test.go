@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
 	ag_require "github.com/stretchr/testify/require"
 )
 
@@ -46,3 +47,32 @@ func TestEncodeDecode_Approve(t *testing.T) {
 		})
 	}
 }
+
+func TestNewApproveInstruction(t *testing.T) {
+	source := ag_solanago.NewWallet().PublicKey()
+	delegate := ag_solanago.NewWallet().PublicKey()
+	owner := ag_solanago.NewWallet().PublicKey()
+
+	inst, err := NewApproveInstruction(1000, source, delegate, owner, nil).ValidateAndBuild()
+	ag_require.NoError(t, err)
+
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, []byte{Instruction_Approve, 0xe8, 0x03, 0, 0, 0, 0, 0, 0}, data)
+
+	ag_require.Equal(t,
+		ag_solanago.AccountMetaSlice{
+			ag_solanago.Meta(source).WRITE(),
+			ag_solanago.Meta(delegate),
+			ag_solanago.Meta(owner).SIGNER(),
+		},
+		ag_solanago.AccountMetaSlice(inst.Accounts()),
+	)
+
+	decoded, err := DecodeInstruction(inst.Accounts(), data)
+	ag_require.NoError(t, err)
+	got := decoded.Impl.(*Approve)
+	ag_require.Equal(t, uint64(1000), *got.Amount)
+	ag_require.Equal(t, delegate, got.GetDelegateAccount().PublicKey)
+	ag_require.Empty(t, got.Signers)
+}
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
 	ag_require "github.com/stretchr/testify/require"
 )
 
@@ -46,3 +47,35 @@ func TestEncodeDecode_BurnChecked(t *testing.T) {
 		})
 	}
 }
+
+func TestNewBurnCheckedInstruction(t *testing.T) {
+	source := ag_solanago.NewWallet().PublicKey()
+	mint := ag_solanago.NewWallet().PublicKey()
+	owner := ag_solanago.NewWallet().PublicKey()
+	signer := ag_solanago.NewWallet().PublicKey()
+
+	inst, err := NewBurnCheckedInstruction(1000, 6, source, mint, owner, []ag_solanago.PublicKey{signer}).ValidateAndBuild()
+	ag_require.NoError(t, err)
+
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	// Instruction type, amount as a little-endian u64, decimals.
+	ag_require.Equal(t, []byte{Instruction_BurnChecked, 0xe8, 0x03, 0, 0, 0, 0, 0, 0, 6}, data)
+
+	ag_require.Equal(t,
+		ag_solanago.AccountMetaSlice{
+			ag_solanago.Meta(source).WRITE(),
+			ag_solanago.Meta(mint).WRITE(),
+			ag_solanago.Meta(owner),
+			ag_solanago.Meta(signer).SIGNER(),
+		},
+		ag_solanago.AccountMetaSlice(inst.Accounts()),
+	)
+
+	decoded, err := DecodeInstruction(inst.Accounts(), data)
+	ag_require.NoError(t, err)
+	got := decoded.Impl.(*BurnChecked)
+	ag_require.Equal(t, uint64(1000), *got.Amount)
+	ag_require.Equal(t, uint8(6), *got.Decimals)
+	ag_require.Equal(t, ag_solanago.AccountMetaSlice{ag_solanago.Meta(signer).SIGNER()}, got.Signers)
+}
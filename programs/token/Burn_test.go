@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
 	ag_require "github.com/stretchr/testify/require"
 )
 
@@ -46,3 +47,37 @@ func TestEncodeDecode_Burn(t *testing.T) {
 		})
 	}
 }
+
+func TestNewBurnInstruction(t *testing.T) {
+	source := ag_solanago.NewWallet().PublicKey()
+	mint := ag_solanago.NewWallet().PublicKey()
+	owner := ag_solanago.NewWallet().PublicKey()
+
+	inst, err := NewBurnInstruction(0x0102030405060708, source, mint, owner, nil).ValidateAndBuild()
+	ag_require.NoError(t, err)
+
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	// Instruction type, then the amount as a little-endian u64.
+	ag_require.Equal(t, []byte{Instruction_Burn, 8, 7, 6, 5, 4, 3, 2, 1}, data)
+
+	ag_require.Equal(t,
+		ag_solanago.AccountMetaSlice{
+			ag_solanago.Meta(source).WRITE(),
+			ag_solanago.Meta(mint).WRITE(),
+			ag_solanago.Meta(owner).SIGNER(),
+		},
+		ag_solanago.AccountMetaSlice(inst.Accounts()),
+	)
+
+	decoded, err := DecodeInstruction(inst.Accounts(), data)
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, uint64(0x0102030405060708), *decoded.Impl.(*Burn).Amount)
+
+	_, err = NewBurnInstructionBuilder().
+		SetSourceAccount(source).
+		SetMintAccount(mint).
+		SetOwnerAccount(owner).
+		ValidateAndBuild()
+	ag_require.EqualError(t, err, "Amount parameter is not set")
+}
@@ -20,6 +20,8 @@ import (
 	"testing"
 
 	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_text "github.com/gagliardetto/solana-go/text"
 	ag_require "github.com/stretchr/testify/require"
 )
 
@@ -46,3 +48,54 @@ func TestEncodeDecode_CloseAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestNewCloseAccountInstruction(t *testing.T) {
+	account := ag_solanago.NewWallet().PublicKey()
+	destination := ag_solanago.NewWallet().PublicKey()
+	owner := ag_solanago.NewWallet().PublicKey()
+	signer := ag_solanago.NewWallet().PublicKey()
+
+	inst, err := NewCloseAccountInstruction(account, destination, owner, []ag_solanago.PublicKey{signer}).ValidateAndBuild()
+	ag_require.NoError(t, err)
+
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, []byte{Instruction_CloseAccount}, data)
+
+	ag_require.Equal(t,
+		ag_solanago.AccountMetaSlice{
+			ag_solanago.Meta(account).WRITE(),
+			ag_solanago.Meta(destination).WRITE(),
+			ag_solanago.Meta(owner),
+			ag_solanago.Meta(signer).SIGNER(),
+		},
+		ag_solanago.AccountMetaSlice(inst.Accounts()),
+	)
+
+	_, err = NewCloseAccountInstructionBuilder().
+		SetAccount(account).
+		SetOwnerAccount(owner).
+		ValidateAndBuild()
+	ag_require.EqualError(t, err, "accounts.Destination is not set")
+}
+
+func TestEncodeToTree_CloseAccount(t *testing.T) {
+	ag_text.DisableColors = true
+	defer func() { ag_text.DisableColors = false }()
+
+	account := ag_solanago.NewWallet().PublicKey()
+	destination := ag_solanago.NewWallet().PublicKey()
+	owner := ag_solanago.NewWallet().PublicKey()
+	signer := ag_solanago.NewWallet().PublicKey()
+
+	buf := new(bytes.Buffer)
+	enc := ag_text.NewTreeEncoder(buf, "")
+	NewCloseAccountInstruction(account, destination, owner, []ag_solanago.PublicKey{signer}).EncodeToTree(enc)
+	tree := enc.Tree.String()
+	ag_require.Contains(t, tree, "CloseAccount")
+	ag_require.Contains(t, tree, account.String())
+	ag_require.Contains(t, tree, destination.String())
+	ag_require.Contains(t, tree, owner.String())
+	ag_require.Contains(t, tree, "signers[len=1]")
+	ag_require.Contains(t, tree, signer.String())
+}
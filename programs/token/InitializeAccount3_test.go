@@ -17,6 +17,7 @@ package token
 import (
 	"bytes"
 	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
 	ag_require "github.com/stretchr/testify/require"
 	"strconv"
 	"testing"
@@ -43,3 +44,35 @@ func TestEncodeDecode_InitializeAccount3(t *testing.T) {
 		})
 	}
 }
+
+func TestNewInitializeAccount3Instruction(t *testing.T) {
+	account := ag_solanago.NewWallet().PublicKey()
+	mint := ag_solanago.NewWallet().PublicKey()
+	owner := ag_solanago.NewWallet().PublicKey()
+
+	inst, err := NewInitializeAccount3Instruction(owner, account, mint).ValidateAndBuild()
+	ag_require.NoError(t, err)
+
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, append([]byte{Instruction_InitializeAccount3}, owner[:]...), data)
+
+	// No rent sysvar account is required.
+	ag_require.Equal(t,
+		ag_solanago.AccountMetaSlice{
+			ag_solanago.Meta(account).WRITE(),
+			ag_solanago.Meta(mint),
+		},
+		ag_solanago.AccountMetaSlice(inst.Accounts()),
+	)
+
+	decoded, err := DecodeInstruction(inst.Accounts(), data)
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, owner, *decoded.Impl.(*InitializeAccount3).Owner)
+
+	_, err = NewInitializeAccount3InstructionBuilder().
+		SetAccount(account).
+		SetMintAccount(mint).
+		ValidateAndBuild()
+	ag_require.EqualError(t, err, "Owner parameter is not set")
+}
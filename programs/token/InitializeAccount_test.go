@@ -17,6 +17,7 @@ package token
 import (
 	"bytes"
 	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
 	ag_require "github.com/stretchr/testify/require"
 	"strconv"
 	"testing"
@@ -43,3 +44,43 @@ func TestEncodeDecode_InitializeAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestNewInitializeAccountInstruction(t *testing.T) {
+	account := ag_solanago.NewWallet().PublicKey()
+	mint := ag_solanago.NewWallet().PublicKey()
+	owner := ag_solanago.NewWallet().PublicKey()
+
+	// The builder defaults the rent sysvar account.
+	inst, err := NewInitializeAccountInstructionBuilder().
+		SetAccount(account).
+		SetMintAccount(mint).
+		SetOwnerAccount(owner).
+		ValidateAndBuild()
+	ag_require.NoError(t, err)
+
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, []byte{Instruction_InitializeAccount}, data)
+
+	ag_require.Equal(t,
+		ag_solanago.AccountMetaSlice{
+			ag_solanago.Meta(account).WRITE(),
+			ag_solanago.Meta(mint),
+			ag_solanago.Meta(owner),
+			ag_solanago.Meta(ag_solanago.SysVarRentPubkey),
+		},
+		ag_solanago.AccountMetaSlice(inst.Accounts()),
+	)
+
+	decoded, err := DecodeInstruction(inst.Accounts(), data)
+	ag_require.NoError(t, err)
+	got := decoded.Impl.(*InitializeAccount)
+	ag_require.Equal(t, owner, got.GetOwnerAccount().PublicKey)
+	ag_require.Equal(t, ag_solanago.SysVarRentPubkey, got.GetSysVarRentPubkeyAccount().PublicKey)
+
+	_, err = NewInitializeAccountInstructionBuilder().
+		SetAccount(account).
+		SetMintAccount(mint).
+		ValidateAndBuild()
+	ag_require.Error(t, err)
+}
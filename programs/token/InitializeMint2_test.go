@@ -17,6 +17,7 @@ package token
 import (
 	"bytes"
 	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
 	ag_require "github.com/stretchr/testify/require"
 	"strconv"
 	"testing"
@@ -43,3 +44,49 @@ func TestEncodeDecode_InitializeMint2(t *testing.T) {
 		})
 	}
 }
+
+func TestNewInitializeMint2Instruction(t *testing.T) {
+	mint := ag_solanago.NewWallet().PublicKey()
+	mintAuthority := ag_solanago.NewWallet().PublicKey()
+	freezeAuthority := ag_solanago.NewWallet().PublicKey()
+
+	t.Run("with freeze authority", func(t *testing.T) {
+		inst, err := NewInitializeMint2Instruction(9, mintAuthority, freezeAuthority, mint).ValidateAndBuild()
+		ag_require.NoError(t, err)
+
+		data, err := inst.Data()
+		ag_require.NoError(t, err)
+		expected := append([]byte{Instruction_InitializeMint2, 9}, mintAuthority[:]...)
+		expected = append(expected, 1)
+		expected = append(expected, freezeAuthority[:]...)
+		ag_require.Equal(t, expected, data)
+
+		// No rent sysvar.
+		ag_require.Equal(t,
+			ag_solanago.AccountMetaSlice{ag_solanago.Meta(mint).WRITE()},
+			ag_solanago.AccountMetaSlice(inst.Accounts()),
+		)
+
+		decoded, err := DecodeInstruction(inst.Accounts(), data)
+		ag_require.NoError(t, err)
+		ag_require.Equal(t, &freezeAuthority, decoded.Impl.(*InitializeMint2).FreezeAuthority)
+	})
+	t.Run("without freeze authority", func(t *testing.T) {
+		inst, err := NewInitializeMint2InstructionBuilder().
+			SetDecimals(9).
+			SetMintAuthority(mintAuthority).
+			SetMintAccount(mint).
+			ValidateAndBuild()
+		ag_require.NoError(t, err)
+
+		data, err := inst.Data()
+		ag_require.NoError(t, err)
+		expected := append([]byte{Instruction_InitializeMint2, 9}, mintAuthority[:]...)
+		expected = append(expected, 0)
+		ag_require.Equal(t, expected, data)
+
+		decoded, err := DecodeInstruction(inst.Accounts(), data)
+		ag_require.NoError(t, err)
+		ag_require.Nil(t, decoded.Impl.(*InitializeMint2).FreezeAuthority)
+	})
+}
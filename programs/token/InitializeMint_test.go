@@ -17,6 +17,7 @@ package token
 import (
 	"bytes"
 	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
 	ag_require "github.com/stretchr/testify/require"
 	"strconv"
 	"testing"
@@ -43,3 +44,54 @@ func TestEncodeDecode_InitializeMint(t *testing.T) {
 		})
 	}
 }
+
+func TestNewInitializeMintInstruction(t *testing.T) {
+	mint := ag_solanago.NewWallet().PublicKey()
+	mintAuthority := ag_solanago.NewWallet().PublicKey()
+	freezeAuthority := ag_solanago.NewWallet().PublicKey()
+
+	t.Run("with freeze authority", func(t *testing.T) {
+		inst, err := NewInitializeMintInstruction(6, mintAuthority, freezeAuthority, mint, ag_solanago.SysVarRentPubkey).ValidateAndBuild()
+		ag_require.NoError(t, err)
+
+		data, err := inst.Data()
+		ag_require.NoError(t, err)
+		expected := append([]byte{Instruction_InitializeMint, 6}, mintAuthority[:]...)
+		expected = append(expected, 1)
+		expected = append(expected, freezeAuthority[:]...)
+		ag_require.Equal(t, expected, data)
+
+		ag_require.Equal(t,
+			ag_solanago.AccountMetaSlice{
+				ag_solanago.Meta(mint).WRITE(),
+				ag_solanago.Meta(ag_solanago.SysVarRentPubkey),
+			},
+			ag_solanago.AccountMetaSlice(inst.Accounts()),
+		)
+
+		decoded, err := DecodeInstruction(inst.Accounts(), data)
+		ag_require.NoError(t, err)
+		got := decoded.Impl.(*InitializeMint)
+		ag_require.Equal(t, uint8(6), *got.Decimals)
+		ag_require.Equal(t, mintAuthority, *got.MintAuthority)
+		ag_require.Equal(t, &freezeAuthority, got.FreezeAuthority)
+	})
+	t.Run("without freeze authority", func(t *testing.T) {
+		inst, err := NewInitializeMintInstructionBuilder().
+			SetDecimals(6).
+			SetMintAuthority(mintAuthority).
+			SetMintAccount(mint).
+			ValidateAndBuild()
+		ag_require.NoError(t, err)
+
+		data, err := inst.Data()
+		ag_require.NoError(t, err)
+		expected := append([]byte{Instruction_InitializeMint, 6}, mintAuthority[:]...)
+		expected = append(expected, 0)
+		ag_require.Equal(t, expected, data)
+
+		decoded, err := DecodeInstruction(inst.Accounts(), data)
+		ag_require.NoError(t, err)
+		ag_require.Nil(t, decoded.Impl.(*InitializeMint).FreezeAuthority)
+	})
+}
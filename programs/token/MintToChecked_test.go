@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
 	ag_require "github.com/stretchr/testify/require"
 )
 
@@ -46,3 +47,36 @@ func TestEncodeDecode_MintToChecked(t *testing.T) {
 		})
 	}
 }
+
+func TestNewMintToCheckedInstruction(t *testing.T) {
+	mint := ag_solanago.NewWallet().PublicKey()
+	destination := ag_solanago.NewWallet().PublicKey()
+	authority := ag_solanago.NewWallet().PublicKey()
+	signer1 := ag_solanago.NewWallet().PublicKey()
+	signer2 := ag_solanago.NewWallet().PublicKey()
+
+	inst, err := NewMintToCheckedInstruction(1000, 9, mint, destination, authority, []ag_solanago.PublicKey{signer1, signer2}).ValidateAndBuild()
+	ag_require.NoError(t, err)
+
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, []byte{Instruction_MintToChecked, 0xe8, 0x03, 0, 0, 0, 0, 0, 0, 9}, data)
+
+	ag_require.Equal(t,
+		ag_solanago.AccountMetaSlice{
+			ag_solanago.Meta(mint).WRITE(),
+			ag_solanago.Meta(destination).WRITE(),
+			ag_solanago.Meta(authority),
+			ag_solanago.Meta(signer1).SIGNER(),
+			ag_solanago.Meta(signer2).SIGNER(),
+		},
+		ag_solanago.AccountMetaSlice(inst.Accounts()),
+	)
+
+	decoded, err := DecodeInstruction(inst.Accounts(), data)
+	ag_require.NoError(t, err)
+	got := decoded.Impl.(*MintToChecked)
+	ag_require.Equal(t, uint64(1000), *got.Amount)
+	ag_require.Equal(t, uint8(9), *got.Decimals)
+	ag_require.Len(t, got.Signers, 2)
+}
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
 	ag_require "github.com/stretchr/testify/require"
 )
 
@@ -46,3 +47,44 @@ func TestEncodeDecode_MintTo(t *testing.T) {
 		})
 	}
 }
+
+func TestNewMintToInstruction(t *testing.T) {
+	mint := ag_solanago.NewWallet().PublicKey()
+	destination := ag_solanago.NewWallet().PublicKey()
+	authority := ag_solanago.NewWallet().PublicKey()
+
+	inst, err := NewMintToInstruction(1000, mint, destination, authority, nil).ValidateAndBuild()
+	ag_require.NoError(t, err)
+
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, []byte{Instruction_MintTo, 0xe8, 0x03, 0, 0, 0, 0, 0, 0}, data)
+
+	ag_require.Equal(t,
+		ag_solanago.AccountMetaSlice{
+			ag_solanago.Meta(mint).WRITE(),
+			ag_solanago.Meta(destination).WRITE(),
+			ag_solanago.Meta(authority).SIGNER(),
+		},
+		ag_solanago.AccountMetaSlice(inst.Accounts()),
+	)
+
+	t.Run("authority is not a signer", func(t *testing.T) {
+		got := new(MintTo)
+		ag_require.NoError(t, got.SetAccounts([]*ag_solanago.AccountMeta{
+			ag_solanago.Meta(mint).WRITE(),
+			ag_solanago.Meta(destination).WRITE(),
+			ag_solanago.Meta(authority),
+		}))
+		got.SetAmount(1000)
+		ag_require.EqualError(t, got.Validate(), "accounts.Signers is not set")
+	})
+	t.Run("too many signers", func(t *testing.T) {
+		signers := make([]ag_solanago.PublicKey, MAX_SIGNERS+1)
+		for i := range signers {
+			signers[i] = ag_solanago.NewWallet().PublicKey()
+		}
+		_, err := NewMintToInstruction(1000, mint, destination, authority, signers).ValidateAndBuild()
+		ag_require.EqualError(t, err, "too many signers; got 12, but max is 11")
+	})
+}
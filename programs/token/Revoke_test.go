@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
 	ag_require "github.com/stretchr/testify/require"
 )
 
@@ -46,3 +47,39 @@ func TestEncodeDecode_Revoke(t *testing.T) {
 		})
 	}
 }
+
+func TestNewRevokeInstruction(t *testing.T) {
+	source := ag_solanago.NewWallet().PublicKey()
+	owner := ag_solanago.NewWallet().PublicKey()
+	signer := ag_solanago.NewWallet().PublicKey()
+
+	inst, err := NewRevokeInstruction(source, owner, nil).ValidateAndBuild()
+	ag_require.NoError(t, err)
+
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, []byte{Instruction_Revoke}, data)
+
+	ag_require.Equal(t,
+		ag_solanago.AccountMetaSlice{
+			ag_solanago.Meta(source).WRITE(),
+			ag_solanago.Meta(owner).SIGNER(),
+		},
+		ag_solanago.AccountMetaSlice(inst.Accounts()),
+	)
+
+	// With a multisig owner, signers follow the 2 fixed accounts.
+	inst, err = NewRevokeInstruction(source, owner, []ag_solanago.PublicKey{signer}).ValidateAndBuild()
+	ag_require.NoError(t, err)
+	decoded, err := DecodeInstruction(inst.Accounts(), data)
+	ag_require.NoError(t, err)
+	got := decoded.Impl.(*Revoke)
+	ag_require.Equal(t, owner, got.GetOwnerAccount().PublicKey)
+	ag_require.False(t, got.GetOwnerAccount().IsSigner)
+	ag_require.Equal(t, ag_solanago.AccountMetaSlice{ag_solanago.Meta(signer).SIGNER()}, got.Signers)
+
+	_, err = NewRevokeInstructionBuilder().
+		SetOwnerAccount(owner).
+		ValidateAndBuild()
+	ag_require.EqualError(t, err, "accounts.Source is not set")
+}
@@ -249,3 +249,17 @@ func NewSetAuthorityInstruction(
 		SetSubjectAccount(subject).
 		SetAuthorityAccount(authority, multisigSigners...)
 }
+
+// NewDisableMintAuthority declares a new SetAuthority instruction that sets
+// the MintTokens authority of the mint to None, permanently fixing its supply.
+func NewDisableMintAuthority(
+	// Accounts:
+	mint ag_solanago.PublicKey,
+	currentAuthority ag_solanago.PublicKey,
+	multisigSigners []ag_solanago.PublicKey,
+) *SetAuthority {
+	return NewSetAuthorityInstructionBuilder().
+		SetAuthorityType(AuthorityMintTokens).
+		SetSubjectAccount(mint).
+		SetAuthorityAccount(currentAuthority, multisigSigners...)
+}
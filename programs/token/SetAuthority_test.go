@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
 	ag_require "github.com/stretchr/testify/require"
 )
 
@@ -46,3 +47,80 @@ func TestEncodeDecode_SetAuthority(t *testing.T) {
 		})
 	}
 }
+
+func TestNewDisableMintAuthority(t *testing.T) {
+	mint := ag_solanago.NewWallet().PublicKey()
+	authority := ag_solanago.NewWallet().PublicKey()
+
+	inst, err := NewDisableMintAuthority(mint, authority, nil).ValidateAndBuild()
+	ag_require.NoError(t, err)
+
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	// Instruction type, authority type (MintTokens), COption None.
+	ag_require.Equal(t, []byte{Instruction_SetAuthority, byte(AuthorityMintTokens), 0}, data)
+
+	ag_require.Equal(t,
+		ag_solanago.AccountMetaSlice{
+			ag_solanago.Meta(mint).WRITE(),
+			ag_solanago.Meta(authority).SIGNER(),
+		},
+		ag_solanago.AccountMetaSlice(inst.Accounts()),
+	)
+
+	decoded, err := DecodeInstruction(inst.Accounts(), data)
+	ag_require.NoError(t, err)
+	ag_require.Nil(t, decoded.Impl.(*SetAuthority).NewAuthority)
+}
+
+func TestNewSetAuthorityInstruction(t *testing.T) {
+	account := ag_solanago.NewWallet().PublicKey()
+	owner := ag_solanago.NewWallet().PublicKey()
+	newOwner := ag_solanago.NewWallet().PublicKey()
+	signer := ag_solanago.NewWallet().PublicKey()
+
+	t.Run("some", func(t *testing.T) {
+		inst, err := NewSetAuthorityInstruction(AuthorityAccountOwner, newOwner, account, owner, nil).ValidateAndBuild()
+		ag_require.NoError(t, err)
+
+		data, err := inst.Data()
+		ag_require.NoError(t, err)
+		// Instruction type, authority type, COption Some, new authority.
+		expected := append([]byte{Instruction_SetAuthority, byte(AuthorityAccountOwner), 1}, newOwner[:]...)
+		ag_require.Equal(t, expected, data)
+
+		decoded, err := DecodeInstruction(inst.Accounts(), data)
+		ag_require.NoError(t, err)
+		got := decoded.Impl.(*SetAuthority)
+		ag_require.Equal(t, AuthorityAccountOwner, *got.AuthorityType)
+		ag_require.Equal(t, &newOwner, got.NewAuthority)
+	})
+	t.Run("none", func(t *testing.T) {
+		inst, err := NewSetAuthorityInstructionBuilder().
+			SetAuthorityType(AuthorityCloseAccount).
+			SetSubjectAccount(account).
+			SetAuthorityAccount(owner, signer).
+			ValidateAndBuild()
+		ag_require.NoError(t, err)
+
+		data, err := inst.Data()
+		ag_require.NoError(t, err)
+		ag_require.Equal(t, []byte{Instruction_SetAuthority, byte(AuthorityCloseAccount), 0}, data)
+
+		ag_require.Equal(t,
+			ag_solanago.AccountMetaSlice{
+				ag_solanago.Meta(account).WRITE(),
+				ag_solanago.Meta(owner),
+				ag_solanago.Meta(signer).SIGNER(),
+			},
+			ag_solanago.AccountMetaSlice(inst.Accounts()),
+		)
+
+		decoded, err := DecodeInstruction(inst.Accounts(), data)
+		ag_require.NoError(t, err)
+		got := decoded.Impl.(*SetAuthority)
+		ag_require.Equal(t, AuthorityCloseAccount, *got.AuthorityType)
+		ag_require.Nil(t, got.NewAuthority)
+		ag_require.Len(t, got.Signers, 1)
+	})
+}
@@ -16,10 +16,13 @@ package token
 
 import (
 	"bytes"
-	ag_gofuzz "github.com/gagliardetto/gofuzz"
-	ag_require "github.com/stretchr/testify/require"
 	"strconv"
 	"testing"
+
+	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_text "github.com/gagliardetto/solana-go/text"
+	ag_require "github.com/stretchr/testify/require"
 )
 
 func TestEncodeDecode_SyncNative(t *testing.T) {
@@ -43,3 +46,43 @@ func TestEncodeDecode_SyncNative(t *testing.T) {
 		})
 	}
 }
+
+func TestNewSyncNativeInstruction(t *testing.T) {
+	tokenAccount := ag_solanago.NewWallet().PublicKey()
+
+	inst, err := NewSyncNativeInstruction(tokenAccount).ValidateAndBuild()
+	ag_require.NoError(t, err)
+
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, []byte{Instruction_SyncNative}, data)
+
+	ag_require.Equal(t,
+		ag_solanago.AccountMetaSlice{
+			ag_solanago.Meta(tokenAccount).WRITE(),
+		},
+		ag_solanago.AccountMetaSlice(inst.Accounts()),
+	)
+
+	decoded, err := DecodeInstruction(inst.Accounts(), data)
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, tokenAccount, decoded.Impl.(*SyncNative).GetTokenAccount().PublicKey)
+
+	_, err = NewSyncNativeInstructionBuilder().ValidateAndBuild()
+	ag_require.EqualError(t, err, "accounts.TokenAccount is not set")
+}
+
+func TestEncodeToTree_SyncNative(t *testing.T) {
+	ag_text.DisableColors = true
+	defer func() { ag_text.DisableColors = false }()
+
+	tokenAccount := ag_solanago.NewWallet().PublicKey()
+
+	buf := new(bytes.Buffer)
+	enc := ag_text.NewTreeEncoder(buf, "")
+	NewSyncNativeInstruction(tokenAccount).EncodeToTree(enc)
+	tree := enc.Tree.String()
+	ag_require.Contains(t, tree, "SyncNative")
+	ag_require.Contains(t, tree, "tokenAccount")
+	ag_require.Contains(t, tree, tokenAccount.String())
+}
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
 	ag_require "github.com/stretchr/testify/require"
 )
 
@@ -46,3 +47,61 @@ func TestEncodeDecode_TransferChecked(t *testing.T) {
 		})
 	}
 }
+
+func TestNewTransferCheckedInstruction(t *testing.T) {
+	source := ag_solanago.NewWallet().PublicKey()
+	mint := ag_solanago.NewWallet().PublicKey()
+	destination := ag_solanago.NewWallet().PublicKey()
+	owner := ag_solanago.NewWallet().PublicKey()
+	signer := ag_solanago.NewWallet().PublicKey()
+
+	inst, err := NewTransferCheckedInstruction(1000, 6, source, mint, destination, owner, []ag_solanago.PublicKey{signer}).ValidateAndBuild()
+	ag_require.NoError(t, err)
+
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	// Instruction type, amount as a little-endian u64, decimals.
+	ag_require.Equal(t, []byte{Instruction_TransferChecked, 0xe8, 0x03, 0, 0, 0, 0, 0, 0, 6}, data)
+
+	ag_require.Equal(t,
+		ag_solanago.AccountMetaSlice{
+			ag_solanago.Meta(source).WRITE(),
+			ag_solanago.Meta(mint),
+			ag_solanago.Meta(destination).WRITE(),
+			ag_solanago.Meta(owner),
+			ag_solanago.Meta(signer).SIGNER(),
+		},
+		ag_solanago.AccountMetaSlice(inst.Accounts()),
+	)
+
+	// The first 4 accounts are fixed, the rest are multisig signers.
+	decoded, err := DecodeInstruction(inst.Accounts(), data)
+	ag_require.NoError(t, err)
+	got := decoded.Impl.(*TransferChecked)
+	ag_require.Equal(t, uint64(1000), *got.Amount)
+	ag_require.Equal(t, uint8(6), *got.Decimals)
+	ag_require.Equal(t, mint, got.GetMintAccount().PublicKey)
+	ag_require.Equal(t, owner, got.GetOwnerAccount().PublicKey)
+	ag_require.Equal(t, ag_solanago.AccountMetaSlice{ag_solanago.Meta(signer).SIGNER()}, got.Signers)
+
+	t.Run("decimals not set", func(t *testing.T) {
+		_, err := NewTransferCheckedInstructionBuilder().
+			SetAmount(1000).
+			SetSourceAccount(source).
+			SetMintAccount(mint).
+			SetDestinationAccount(destination).
+			SetOwnerAccount(owner).
+			ValidateAndBuild()
+		ag_require.EqualError(t, err, "Decimals parameter is not set")
+	})
+	t.Run("mint not set", func(t *testing.T) {
+		_, err := NewTransferCheckedInstructionBuilder().
+			SetAmount(1000).
+			SetDecimals(6).
+			SetSourceAccount(source).
+			SetDestinationAccount(destination).
+			SetOwnerAccount(owner).
+			ValidateAndBuild()
+		ag_require.EqualError(t, err, "accounts.Mint is not set")
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
 	ag_require "github.com/stretchr/testify/require"
 )
 
@@ -46,3 +47,54 @@ func TestEncodeDecode_Transfer(t *testing.T) {
 		})
 	}
 }
+
+func TestNewTransferInstruction(t *testing.T) {
+	source := ag_solanago.NewWallet().PublicKey()
+	destination := ag_solanago.NewWallet().PublicKey()
+	owner := ag_solanago.NewWallet().PublicKey()
+	signer1 := ag_solanago.NewWallet().PublicKey()
+	signer2 := ag_solanago.NewWallet().PublicKey()
+
+	t.Run("single owner", func(t *testing.T) {
+		inst, err := NewTransferInstruction(0x0102030405060708, source, destination, owner, nil).ValidateAndBuild()
+		ag_require.NoError(t, err)
+
+		data, err := inst.Data()
+		ag_require.NoError(t, err)
+		// Instruction type, then the amount as a little-endian u64.
+		ag_require.Equal(t, []byte{Instruction_Transfer, 8, 7, 6, 5, 4, 3, 2, 1}, data)
+
+		ag_require.Equal(t,
+			ag_solanago.AccountMetaSlice{
+				ag_solanago.Meta(source).WRITE(),
+				ag_solanago.Meta(destination).WRITE(),
+				ag_solanago.Meta(owner).SIGNER(),
+			},
+			ag_solanago.AccountMetaSlice(inst.Accounts()),
+		)
+	})
+	t.Run("multisig owner", func(t *testing.T) {
+		inst, err := NewTransferInstruction(1000, source, destination, owner, []ag_solanago.PublicKey{signer1, signer2}).ValidateAndBuild()
+		ag_require.NoError(t, err)
+
+		ag_require.Equal(t,
+			ag_solanago.AccountMetaSlice{
+				ag_solanago.Meta(source).WRITE(),
+				ag_solanago.Meta(destination).WRITE(),
+				ag_solanago.Meta(owner),
+				ag_solanago.Meta(signer1).SIGNER(),
+				ag_solanago.Meta(signer2).SIGNER(),
+			},
+			ag_solanago.AccountMetaSlice(inst.Accounts()),
+		)
+
+		data, err := inst.Data()
+		ag_require.NoError(t, err)
+		decoded, err := DecodeInstruction(inst.Accounts(), data)
+		ag_require.NoError(t, err)
+		transfer := decoded.Impl.(*Transfer)
+		ag_require.Equal(t, uint64(1000), *transfer.Amount)
+		ag_require.Equal(t, owner, transfer.GetOwnerAccount().PublicKey)
+		ag_require.Len(t, transfer.Signers, 2)
+	})
+}
@@ -42,23 +42,11 @@ type Mint struct {
 
 func (mint *Mint) UnmarshalWithDecoder(dec *bin.Decoder) (err error) {
 	{
-		v, err := dec.ReadUint32(binary.LittleEndian)
+		v, err := solana.DecodeCOptionPublicKey(dec)
 		if err != nil {
 			return err
 		}
-		if v == 1 {
-			v, err := dec.ReadNBytes(32)
-			if err != nil {
-				return err
-			}
-			mint.MintAuthority = solana.PublicKeyFromBytes(v).ToPointer()
-		} else {
-			// discard:
-			_, err := dec.ReadNBytes(32)
-			if err != nil {
-				return err
-			}
-		}
+		mint.MintAuthority = v
 	}
 	{
 		v, err := dec.ReadUint64(binary.LittleEndian)
@@ -82,48 +70,20 @@ func (mint *Mint) UnmarshalWithDecoder(dec *bin.Decoder) (err error) {
 		mint.IsInitialized = v
 	}
 	{
-		v, err := dec.ReadUint32(binary.LittleEndian)
+		v, err := solana.DecodeCOptionPublicKey(dec)
 		if err != nil {
 			return err
 		}
-		if v == 1 {
-			v, err := dec.ReadNBytes(32)
-			if err != nil {
-				return err
-			}
-			mint.FreezeAuthority = solana.PublicKeyFromBytes(v).ToPointer()
-		} else {
-			// discard:
-			_, err := dec.ReadNBytes(32)
-			if err != nil {
-				return err
-			}
-		}
+		mint.FreezeAuthority = v
 	}
 	return nil
 }
 
 func (mint Mint) MarshalWithEncoder(encoder *bin.Encoder) (err error) {
 	{
-		if mint.MintAuthority == nil {
-			err = encoder.WriteUint32(0, binary.LittleEndian)
-			if err != nil {
-				return err
-			}
-			empty := solana.PublicKey{}
-			err = encoder.WriteBytes(empty[:], false)
-			if err != nil {
-				return err
-			}
-		} else {
-			err = encoder.WriteUint32(1, binary.LittleEndian)
-			if err != nil {
-				return err
-			}
-			err = encoder.WriteBytes(mint.MintAuthority[:], false)
-			if err != nil {
-				return err
-			}
+		err = solana.EncodeCOptionPublicKey(encoder, mint.MintAuthority)
+		if err != nil {
+			return err
 		}
 	}
 	err = encoder.WriteUint64(mint.Supply, binary.LittleEndian)
@@ -139,25 +99,9 @@ func (mint Mint) MarshalWithEncoder(encoder *bin.Encoder) (err error) {
 		return err
 	}
 	{
-		if mint.FreezeAuthority == nil {
-			err = encoder.WriteUint32(0, binary.LittleEndian)
-			if err != nil {
-				return err
-			}
-			empty := solana.PublicKey{}
-			err = encoder.WriteBytes(empty[:], false)
-			if err != nil {
-				return err
-			}
-		} else {
-			err = encoder.WriteUint32(1, binary.LittleEndian)
-			if err != nil {
-				return err
-			}
-			err = encoder.WriteBytes(mint.FreezeAuthority[:], false)
-			if err != nil {
-				return err
-			}
+		err = solana.EncodeCOptionPublicKey(encoder, mint.FreezeAuthority)
+		if err != nil {
+			return err
 		}
 	}
 	return nil
@@ -215,23 +159,11 @@ func (mint *Account) UnmarshalWithDecoder(dec *bin.Decoder) (err error) {
 		mint.Amount = v
 	}
 	{
-		v, err := dec.ReadUint32(binary.LittleEndian)
+		v, err := solana.DecodeCOptionPublicKey(dec)
 		if err != nil {
 			return err
 		}
-		if v == 1 {
-			v, err := dec.ReadNBytes(32)
-			if err != nil {
-				return err
-			}
-			mint.Delegate = solana.PublicKeyFromBytes(v).ToPointer()
-		} else {
-			// discard:
-			_, err := dec.ReadNBytes(32)
-			if err != nil {
-				return err
-			}
-		}
+		mint.Delegate = v
 	}
 	{
 		v, err := dec.ReadUint8()
@@ -241,23 +173,11 @@ func (mint *Account) UnmarshalWithDecoder(dec *bin.Decoder) (err error) {
 		mint.State = AccountState(v)
 	}
 	{
-		v, err := dec.ReadUint32(binary.LittleEndian)
+		v, err := solana.DecodeCOptionUint64(dec)
 		if err != nil {
 			return err
 		}
-		if v == 1 {
-			v, err := dec.ReadUint64(bin.LE)
-			if err != nil {
-				return err
-			}
-			mint.IsNative = &v
-		} else {
-			// discard:
-			_, err := dec.ReadUint64(bin.LE)
-			if err != nil {
-				return err
-			}
-		}
+		mint.IsNative = v
 	}
 	{
 		v, err := dec.ReadUint64(binary.LittleEndian)
@@ -267,23 +187,11 @@ func (mint *Account) UnmarshalWithDecoder(dec *bin.Decoder) (err error) {
 		mint.DelegatedAmount = v
 	}
 	{
-		v, err := dec.ReadUint32(binary.LittleEndian)
+		v, err := solana.DecodeCOptionPublicKey(dec)
 		if err != nil {
 			return err
 		}
-		if v == 1 {
-			v, err := dec.ReadNBytes(32)
-			if err != nil {
-				return err
-			}
-			mint.CloseAuthority = solana.PublicKeyFromBytes(v).ToPointer()
-		} else {
-			// discard:
-			_, err := dec.ReadNBytes(32)
-			if err != nil {
-				return err
-			}
-		}
+		mint.CloseAuthority = v
 	}
 	return nil
 }
@@ -308,25 +216,9 @@ func (mint Account) MarshalWithEncoder(encoder *bin.Encoder) (err error) {
 		}
 	}
 	{
-		if mint.Delegate == nil {
-			err = encoder.WriteUint32(0, binary.LittleEndian)
-			if err != nil {
-				return err
-			}
-			empty := solana.PublicKey{}
-			err = encoder.WriteBytes(empty[:], false)
-			if err != nil {
-				return err
-			}
-		} else {
-			err = encoder.WriteUint32(1, binary.LittleEndian)
-			if err != nil {
-				return err
-			}
-			err = encoder.WriteBytes(mint.Delegate[:], false)
-			if err != nil {
-				return err
-			}
+		err = solana.EncodeCOptionPublicKey(encoder, mint.Delegate)
+		if err != nil {
+			return err
 		}
 	}
 	err = encoder.WriteUint8(uint8(mint.State))
@@ -334,24 +226,9 @@ func (mint Account) MarshalWithEncoder(encoder *bin.Encoder) (err error) {
 		return err
 	}
 	{
-		if mint.IsNative == nil {
-			err = encoder.WriteUint32(0, binary.LittleEndian)
-			if err != nil {
-				return err
-			}
-			err = encoder.WriteUint64(0, bin.LE)
-			if err != nil {
-				return err
-			}
-		} else {
-			err = encoder.WriteUint32(1, binary.LittleEndian)
-			if err != nil {
-				return err
-			}
-			err = encoder.WriteUint64(*mint.IsNative, bin.LE)
-			if err != nil {
-				return err
-			}
+		err = solana.EncodeCOptionUint64(encoder, mint.IsNative)
+		if err != nil {
+			return err
 		}
 	}
 	{
@@ -361,25 +238,9 @@ func (mint Account) MarshalWithEncoder(encoder *bin.Encoder) (err error) {
 		}
 	}
 	{
-		if mint.CloseAuthority == nil {
-			err = encoder.WriteUint32(0, binary.LittleEndian)
-			if err != nil {
-				return err
-			}
-			empty := solana.PublicKey{}
-			err = encoder.WriteBytes(empty[:], false)
-			if err != nil {
-				return err
-			}
-		} else {
-			err = encoder.WriteUint32(1, binary.LittleEndian)
-			if err != nil {
-				return err
-			}
-			err = encoder.WriteBytes(mint.CloseAuthority[:], false)
-			if err != nil {
-				return err
-			}
+		err = solana.EncodeCOptionPublicKey(encoder, mint.CloseAuthority)
+		if err != nil {
+			return err
 		}
 	}
 	return nil
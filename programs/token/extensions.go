@@ -0,0 +1,140 @@
+// Copyright 2021 github.com/gagliardetto
+// This file has been modified by github.com/gagliardetto
+//
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+
+package token
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// ExtensionType is the type of a Token-2022 extension.
+type ExtensionType uint16
+
+const (
+	ExtensionUninitialized ExtensionType = iota
+	ExtensionTransferFeeConfig
+	ExtensionTransferFeeAmount
+	ExtensionMintCloseAuthority
+	ExtensionConfidentialTransferMint
+	ExtensionConfidentialTransferAccount
+	ExtensionDefaultAccountState
+	ExtensionImmutableOwner
+	ExtensionMemoTransfer
+	ExtensionNonTransferable
+	ExtensionInterestBearingConfig
+	ExtensionCpiGuard
+	ExtensionPermanentDelegate
+	ExtensionNonTransferableAccount
+	ExtensionTransferHook
+	ExtensionTransferHookAccount
+	ExtensionConfidentialTransferFeeConfig
+	ExtensionConfidentialTransferFeeAmount
+	ExtensionMetadataPointer
+	ExtensionTokenMetadata
+	ExtensionGroupPointer
+	ExtensionTokenGroup
+	ExtensionGroupMemberPointer
+	ExtensionTokenGroupMember
+)
+
+// Token-2022 account types, stored right after the base state.
+const (
+	accountTypeUninitialized uint8 = iota
+	accountTypeMint
+	accountTypeAccount
+)
+
+// Size of a base Token account; the account type of a Token-2022 mint with extensions
+// is stored at this offset (the base mint is padded to this size),
+// so that mints and accounts can't be confused.
+const extensionsAccountTypeOffset = 165
+
+// Extension is a TLV-encoded Token-2022 extension.
+type Extension struct {
+	Type ExtensionType
+	// Raw value of the extension.
+	Data []byte
+}
+
+// DecodeMintExtensions decodes the extensions of a Token-2022 mint from the account data;
+// it returns no extensions for a mint without extensions
+// (i.e. with just the base MINT_SIZE bytes).
+func DecodeMintExtensions(data []byte) ([]Extension, error) {
+	if len(data) < MINT_SIZE {
+		return nil, fmt.Errorf("invalid mint: data too short (%d bytes)", len(data))
+	}
+	if len(data) == MINT_SIZE {
+		return nil, nil
+	}
+	if len(data) <= extensionsAccountTypeOffset {
+		return nil, fmt.Errorf("invalid mint: unexpected data size (%d bytes)", len(data))
+	}
+	if accountType := data[extensionsAccountTypeOffset]; accountType != accountTypeMint {
+		return nil, fmt.Errorf("invalid mint: account type is %d", accountType)
+	}
+	return decodeExtensions(data[extensionsAccountTypeOffset+1:])
+}
+
+func decodeExtensions(tlv []byte) ([]Extension, error) {
+	out := make([]Extension, 0)
+	for len(tlv) > 0 {
+		if len(tlv) < 4 {
+			return nil, errors.New("invalid extension: truncated type-length header")
+		}
+		typ := ExtensionType(binary.LittleEndian.Uint16(tlv[0:2]))
+		if typ == ExtensionUninitialized {
+			// The rest of the data is unused.
+			break
+		}
+		length := int(binary.LittleEndian.Uint16(tlv[2:4]))
+		if len(tlv)-4 < length {
+			return nil, fmt.Errorf("invalid extension %d: length %d exceeds data", typ, length)
+		}
+		out = append(out, Extension{
+			Type: typ,
+			Data: tlv[4 : 4+length],
+		})
+		tlv = tlv[4+length:]
+	}
+	return out, nil
+}
+
+// GetExtension returns the first extension of the provided type, if present.
+func GetExtension(extensions []Extension, typ ExtensionType) (*Extension, bool) {
+	for i := range extensions {
+		if extensions[i].Type == typ {
+			return &extensions[i], true
+		}
+	}
+	return nil, false
+}
+
+// GetDefaultAccountState returns the state that new token accounts of the mint are initialized with,
+// as set by the DefaultAccountState extension;
+// ok is false if the mint doesn't have the extension
+// (i.e. new accounts are Initialized).
+// A Frozen state means that tokens received in new accounts arrive frozen.
+func GetDefaultAccountState(extensions []Extension) (state AccountState, ok bool, err error) {
+	ext, ok := GetExtension(extensions, ExtensionDefaultAccountState)
+	if !ok {
+		return Initialized, false, nil
+	}
+	if len(ext.Data) != 1 {
+		return 0, true, fmt.Errorf("invalid DefaultAccountState extension: expected 1 byte, got %d", len(ext.Data))
+	}
+	return AccountState(ext.Data[0]), true, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func appendTLV(data []byte, typ ExtensionType, value []byte) []byte {
+	header := make([]byte, 4)
+	binary.LittleEndian.PutUint16(header[0:], uint16(typ))
+	binary.LittleEndian.PutUint16(header[2:], uint16(len(value)))
+	return append(append(data, header...), value...)
+}
+
+func TestDecodeMintExtensions_DefaultAccountState(t *testing.T) {
+	freezeAuthority := solana.MustPublicKeyFromBase58("4Qkev8aNZcqFNSRhQzwyLMFSsi94jHqE8WNVTJzTP99F")
+	closeAuthority := solana.MustPublicKeyFromBase58("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM")
+	mint := Mint{
+		MintAuthority:   solana.MustPublicKeyFromBase58("7xLk17EQQ5KLDLDe44wCmupJKJjTGd8hs3eSVVhCx932").ToPointer(),
+		Supply:          1000000,
+		Decimals:        6,
+		IsInitialized:   true,
+		FreezeAuthority: &freezeAuthority,
+	}
+	buf := new(bytes.Buffer)
+	require.NoError(t, bin.NewBinEncoder(buf).Encode(mint))
+	require.Equal(t, MINT_SIZE, buf.Len())
+
+	// Base mint, padded to the size of an account, followed by the account type and the extensions.
+	data := make([]byte, extensionsAccountTypeOffset)
+	copy(data, buf.Bytes())
+	data = append(data, accountTypeMint)
+	data = appendTLV(data, ExtensionMintCloseAuthority, closeAuthority[:])
+	data = appendTLV(data, ExtensionDefaultAccountState, []byte{byte(Frozen)})
+
+	// The base mint is decoded as usual:
+	var decoded Mint
+	require.NoError(t, bin.NewBinDecoder(data).Decode(&decoded))
+	require.Equal(t, mint, decoded)
+
+	extensions, err := DecodeMintExtensions(data)
+	require.NoError(t, err)
+	require.Equal(t,
+		[]Extension{
+			{Type: ExtensionMintCloseAuthority, Data: closeAuthority[:]},
+			{Type: ExtensionDefaultAccountState, Data: []byte{2}},
+		},
+		extensions,
+	)
+
+	state, ok, err := GetDefaultAccountState(extensions)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, Frozen, state)
+}
+
+func TestDecodeMintExtensions_NoExtensions(t *testing.T) {
+	extensions, err := DecodeMintExtensions(make([]byte, MINT_SIZE))
+	require.NoError(t, err)
+	require.Empty(t, extensions)
+
+	state, ok, err := GetDefaultAccountState(extensions)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, Initialized, state)
+}
+
+func TestDecodeMintExtensions_Invalid(t *testing.T) {
+	{
+		// Not a mint.
+		data := make([]byte, extensionsAccountTypeOffset+1)
+		data[extensionsAccountTypeOffset] = accountTypeAccount
+		_, err := DecodeMintExtensions(data)
+		require.Error(t, err)
+	}
+	{
+		// Truncated extension.
+		data := make([]byte, extensionsAccountTypeOffset)
+		data = append(data, accountTypeMint)
+		data = appendTLV(data, ExtensionDefaultAccountState, []byte{byte(Frozen)})
+		_, err := DecodeMintExtensions(data[:len(data)-1])
+		require.Error(t, err)
+	}
+	{
+		// Wrong size of the DefaultAccountState value.
+		_, _, err := GetDefaultAccountState([]Extension{{Type: ExtensionDefaultAccountState, Data: []byte{2, 0}}})
+		require.Error(t, err)
+	}
+}
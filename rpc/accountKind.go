@@ -0,0 +1,142 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"encoding/binary"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// AccountKind is the kind of an account, as inferred from its owner program and data.
+type AccountKind int
+
+const (
+	AccountKindUnknown AccountKind = iota
+	// An account owned by the system program with no data (e.g. a wallet).
+	AccountKindSystemOwned
+	// A durable nonce account.
+	AccountKindNonceAccount
+	AccountKindTokenMint
+	AccountKindTokenAccount
+	AccountKindTokenMultisig
+	AccountKindStakeAccount
+	AccountKindVoteAccount
+	AccountKindLookupTable
+	// An executable program account.
+	AccountKindProgramAccount
+)
+
+func (kind AccountKind) String() string {
+	switch kind {
+	case AccountKindSystemOwned:
+		return "SystemOwned"
+	case AccountKindNonceAccount:
+		return "NonceAccount"
+	case AccountKindTokenMint:
+		return "TokenMint"
+	case AccountKindTokenAccount:
+		return "TokenAccount"
+	case AccountKindTokenMultisig:
+		return "TokenMultisig"
+	case AccountKindStakeAccount:
+		return "StakeAccount"
+	case AccountKindVoteAccount:
+		return "VoteAccount"
+	case AccountKindLookupTable:
+		return "LookupTable"
+	case AccountKindProgramAccount:
+		return "ProgramAccount"
+	default:
+		return "Unknown"
+	}
+}
+
+// Sizes of the accounts of the token program, in bytes.
+const (
+	tokenMintSize     = 82
+	tokenAccountSize  = 165
+	tokenMultisigSize = 355
+)
+
+// Size of a nonce account of the system program, in bytes.
+const nonceAccountSize = 80
+
+// Token-2022 accounts with extensions carry their type right after
+// the base account layout (i.e. at offset tokenAccountSize).
+const (
+	token2022AccountTypeMint    = 1
+	token2022AccountTypeAccount = 2
+)
+
+// ClassifyAccount returns the kind of the provided account,
+// based on its owner program and on the size (or discriminator) of its data.
+// The account data must be binary encoded (e.g. base64);
+// accounts fetched with the jsonParsed encoding are classified
+// by their owner only, when possible.
+func ClassifyAccount(account *Account) AccountKind {
+	if account == nil {
+		return AccountKindUnknown
+	}
+	var data []byte
+	if account.Data != nil {
+		data = account.Data.GetBinary()
+	}
+	switch {
+	case account.Owner.Equals(solana.SystemProgramID):
+		if len(data) == nonceAccountSize {
+			return AccountKindNonceAccount
+		}
+		if len(data) == 0 {
+			return AccountKindSystemOwned
+		}
+	case account.Owner.Equals(solana.TokenProgramID), account.Owner.Equals(solana.Token2022ProgramID):
+		return classifyTokenAccount(account.Owner, data)
+	case account.Owner.Equals(solana.StakeProgramID):
+		return AccountKindStakeAccount
+	case account.Owner.Equals(solana.VoteProgramID):
+		return AccountKindVoteAccount
+	case account.Owner.Equals(solana.AddressLookupTableProgramID):
+		return AccountKindLookupTable
+	case account.Owner.Equals(solana.BPFLoaderUpgradeableProgramID):
+		if len(data) >= 4 && binary.LittleEndian.Uint32(data) == upgradeableLoaderStateProgram {
+			return AccountKindProgramAccount
+		}
+	}
+	if account.Executable {
+		return AccountKindProgramAccount
+	}
+	return AccountKindUnknown
+}
+
+func classifyTokenAccount(owner solana.PublicKey, data []byte) AccountKind {
+	switch len(data) {
+	case tokenMintSize:
+		return AccountKindTokenMint
+	case tokenAccountSize:
+		return AccountKindTokenAccount
+	case tokenMultisigSize:
+		return AccountKindTokenMultisig
+	}
+	if owner.Equals(solana.Token2022ProgramID) && len(data) > tokenAccountSize {
+		switch data[tokenAccountSize] {
+		case token2022AccountTypeMint:
+			return AccountKindTokenMint
+		case token2022AccountTypeAccount:
+			return AccountKindTokenAccount
+		}
+	}
+	return AccountKindUnknown
+}
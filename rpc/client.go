@@ -44,13 +44,18 @@ type JSONRPCClient interface {
 
 // New creates a new Solana JSON RPC client.
 // Client is safe for concurrent use by multiple goroutines.
+//
+// The rpcEndpoint is used as-is for every request, including its path
+// and query string (e.g. "https://example.com/rpc/v1?api-key=<KEY>").
 func New(rpcEndpoint string) *Client {
 	opts := &jsonrpc.RPCClientOpts{
 		HTTPClient: newHTTP(),
 	}
 
 	rpcClient := jsonrpc.NewClientWithOpts(rpcEndpoint, opts)
-	return NewWithCustomRPCClient(rpcClient)
+	cl := NewWithCustomRPCClient(rpcClient)
+	cl.rpcURL = rpcEndpoint
+	return cl
 }
 
 // New creates a new Solana JSON RPC client with the provided custom headers.
@@ -61,7 +66,16 @@ func NewWithHeaders(rpcEndpoint string, headers map[string]string) *Client {
 		CustomHeaders: headers,
 	}
 	rpcClient := jsonrpc.NewClientWithOpts(rpcEndpoint, opts)
-	return NewWithCustomRPCClient(rpcClient)
+	cl := NewWithCustomRPCClient(rpcClient)
+	cl.rpcURL = rpcEndpoint
+	return cl
+}
+
+// WithRequestID returns a copy of ctx that carries the provided trace/request id.
+// The id is sent in the X-Request-Id HTTP header of each request made with the returned context,
+// so that requests can be correlated with the logs of the RPC provider.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return jsonrpc.WithRequestID(ctx, id)
 }
 
 // Close closes the client.
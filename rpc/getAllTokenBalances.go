@@ -0,0 +1,191 @@
+// Copyright 2021 github.com/gagliardetto
+// This file has been modified by github.com/gagliardetto
+//
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+
+package rpc
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// TokenHolding is the balance of an SPL Token (or Token-2022) account.
+type TokenHolding struct {
+	// The token account.
+	Account solana.PublicKey
+	// The token program owning the account
+	// (solana.TokenProgramID or solana.Token2022ProgramID).
+	Program solana.PublicKey
+	// The mint of the token held by the account.
+	Mint solana.PublicKey
+	// Raw amount of tokens, without accounting for decimals.
+	Amount uint64
+	// Number of decimals configured for the token's mint.
+	Decimals uint8
+}
+
+// GetAllTokenBalancesOpts are the options of GetAllTokenBalancesWithOpts.
+type GetAllTokenBalancesOpts struct {
+	Commitment CommitmentType
+
+	// BalanceOnly requests only the amount of each token account
+	// (via `dataSlice`), instead of the whole parsed account,
+	// which reduces the size of the response.
+	// The Mint and Decimals of the returned holdings are not set.
+	BalanceOnly bool
+}
+
+// The amount of an SPL Token account is the u64 following the mint and the owner.
+const (
+	tokenAccountAmountOffset = 64
+	tokenAccountAmountLength = 8
+)
+
+// GetAllTokenBalances returns the balances of all the SPL Token and Token-2022 accounts
+// owned by the provided owner, fetched with a `getTokenAccountsByOwner` call per token program.
+func (cl *Client) GetAllTokenBalances(
+	ctx context.Context,
+	owner solana.PublicKey,
+	commitment CommitmentType,
+) ([]TokenHolding, error) {
+	return cl.GetAllTokenBalancesWithOpts(
+		ctx,
+		owner,
+		&GetAllTokenBalancesOpts{
+			Commitment: commitment,
+		},
+	)
+}
+
+// GetAllTokenBalancesWithOpts returns the balances of all the SPL Token and Token-2022 accounts
+// owned by the provided owner, fetched with a `getTokenAccountsByOwner` call per token program;
+// the Token accounts come first.
+func (cl *Client) GetAllTokenBalancesWithOpts(
+	ctx context.Context,
+	owner solana.PublicKey,
+	opts *GetAllTokenBalancesOpts,
+) ([]TokenHolding, error) {
+	if opts == nil {
+		opts = &GetAllTokenBalancesOpts{}
+	}
+	accountsOpts := &GetTokenAccountsOpts{
+		Commitment: opts.Commitment,
+		Encoding:   solana.EncodingJSONParsed,
+	}
+	if opts.BalanceOnly {
+		accountsOpts.Encoding = solana.EncodingBase64
+		offset, length := uint64(tokenAccountAmountOffset), uint64(tokenAccountAmountLength)
+		accountsOpts.DataSlice = &DataSlice{
+			Offset: &offset,
+			Length: &length,
+		}
+	}
+
+	var out []TokenHolding
+	for _, program := range tokenPrograms {
+		holdings, err := cl.getTokenBalancesOfProgram(ctx, owner, program.id, program.parsedName, accountsOpts, opts.BalanceOnly)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, holdings...)
+	}
+	return out, nil
+}
+
+// The token programs queried by GetAllTokenBalancesWithOpts,
+// with the program name of their jsonParsed accounts.
+var tokenPrograms = []struct {
+	id         solana.PublicKey
+	parsedName string
+}{
+	{id: solana.TokenProgramID, parsedName: "spl-token"},
+	{id: solana.Token2022ProgramID, parsedName: "spl-token-2022"},
+}
+
+func (cl *Client) getTokenBalancesOfProgram(
+	ctx context.Context,
+	owner solana.PublicKey,
+	programID solana.PublicKey,
+	parsedName string,
+	accountsOpts *GetTokenAccountsOpts,
+	balanceOnly bool,
+) ([]TokenHolding, error) {
+	res, err := cl.GetTokenAccountsByOwner(
+		ctx,
+		owner,
+		&GetTokenAccountsConfig{
+			ProgramId: &programID,
+		},
+		accountsOpts,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	out := make([]TokenHolding, 0, len(res.Value))
+	for _, acc := range res.Value {
+		if acc == nil {
+			continue
+		}
+		if balanceOnly {
+			data := acc.Account.Data.GetBinary()
+			if len(data) != tokenAccountAmountLength {
+				return nil, fmt.Errorf("token account %s: expected %d bytes of data, got %d", acc.Pubkey, tokenAccountAmountLength, len(data))
+			}
+			out = append(out, TokenHolding{
+				Account: acc.Pubkey,
+				Program: programID,
+				Amount:  binary.LittleEndian.Uint64(data),
+			})
+			continue
+		}
+		var parsed parsedTokenAccount
+		if err := acc.Account.Data.decodeParsed(parsedName, &parsed); err != nil {
+			return nil, fmt.Errorf("token account %s: %w", acc.Pubkey, err)
+		}
+		if parsed.Info == nil {
+			return nil, fmt.Errorf("token account %s: parsed info is missing", acc.Pubkey)
+		}
+		amount, err := strconv.ParseUint(parsed.Info.TokenAmount.Amount, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("token account %s: invalid amount: %w", acc.Pubkey, err)
+		}
+		out = append(out, TokenHolding{
+			Account:  acc.Pubkey,
+			Program:  programID,
+			Mint:     parsed.Info.Mint,
+			Amount:   amount,
+			Decimals: parsed.Info.TokenAmount.Decimals,
+		})
+	}
+	return out, nil
+}
+
+type parsedTokenAccount struct {
+	// "account" for token accounts.
+	Type string                  `json:"type"`
+	Info *parsedTokenAccountInfo `json:"info,omitempty"`
+}
+
+type parsedTokenAccountInfo struct {
+	Mint        solana.PublicKey `json:"mint"`
+	Owner       solana.PublicKey `json:"owner"`
+	TokenAmount UiTokenAmount    `json:"tokenAmount"`
+}
@@ -16,6 +16,7 @@ package rpc
 
 import (
 	"context"
+	"time"
 )
 
 // GetBlockHeight returns the current block height of the node.
@@ -30,3 +31,35 @@ func (cl *Client) GetBlockHeight(
 	err = cl.rpcClient.CallForInto(ctx, &out, "getBlockHeight", params)
 	return
 }
+
+// Interval between getBlockHeight calls in WaitForBlockHeight:
+// a new block is produced at most once per slot.
+var blockHeightPollInterval = DefaultSlotDuration
+
+// WaitForBlockHeight polls the block height of the node until it reaches `target`,
+// or the context is done.
+//
+// Waiting for the `lastValidBlockHeight` returned along with a blockhash
+// tells when a transaction using that blockhash can no longer land.
+func (cl *Client) WaitForBlockHeight(
+	ctx context.Context,
+	target uint64,
+	commitment CommitmentType, // optional
+) error {
+	ticker := time.NewTicker(blockHeightPollInterval)
+	defer ticker.Stop()
+	for {
+		height, err := cl.GetBlockHeight(ctx, commitment)
+		if err != nil {
+			return err
+		}
+		if height >= target {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
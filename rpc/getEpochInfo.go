@@ -16,6 +16,7 @@ package rpc
 
 import (
 	"context"
+	"time"
 )
 
 // GetEpochInfo returns information about the current epoch.
@@ -49,3 +50,37 @@ type GetEpochInfoResult struct {
 
 	TransactionCount *uint64 `json:"transactionCount,omitempty"`
 }
+
+// DefaultSlotDuration is the target duration of a slot,
+// used to estimate the time needed by the cluster to produce a number of slots.
+const DefaultSlotDuration = 400 * time.Millisecond
+
+// SlotsRemaining returns the number of slots remaining before the start of the next epoch.
+func (res *GetEpochInfoResult) SlotsRemaining() uint64 {
+	if res.SlotIndex >= res.SlotsInEpoch {
+		return 0
+	}
+	return res.SlotsInEpoch - res.SlotIndex
+}
+
+// EstimatedTimeRemaining returns an estimate of the time remaining before
+// the start of the next epoch, assuming slots of DefaultSlotDuration.
+func (res *GetEpochInfoResult) EstimatedTimeRemaining() time.Duration {
+	return time.Duration(res.SlotsRemaining()) * DefaultSlotDuration
+}
+
+// SlotsUntilNextEpoch returns the number of slots remaining before the start of the next epoch.
+// Use GetEpochInfoResult.EstimatedTimeRemaining to get an estimate of the matching duration.
+func (cl *Client) SlotsUntilNextEpoch(
+	ctx context.Context,
+	commitment CommitmentType, // optional
+) (uint64, error) {
+	info, err := cl.GetEpochInfo(ctx, commitment)
+	if err != nil {
+		return 0, err
+	}
+	if info == nil {
+		return 0, ErrNotFound
+	}
+	return info.SlotsRemaining(), nil
+}
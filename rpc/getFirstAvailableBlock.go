@@ -16,10 +16,67 @@ package rpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 )
 
-// GetFirstAvailableBlock returns the slot of the lowest confirmed block that has not been purged from the ledger.
+// GetFirstAvailableBlock returns the slot of the lowest confirmed block
+// that has not been purged from the ledger.
+//
+// NOTE: this is the lowest slot for which GetBlock can succeed;
+// MinimumLedgerSlot can be lower, as it includes slots that
+// the node has information about but no confirmed block for.
 func (cl *Client) GetFirstAvailableBlock(ctx context.Context) (out uint64, err error) {
 	err = cl.rpcClient.CallForInto(ctx, &out, "getFirstAvailableBlock", nil)
 	return
 }
+
+// ErrTransactionPredatesHistory is returned by GetTransaction, when
+// GetTransactionOpts.CheckHistoricalCoverage is set, if the transaction
+// was processed in a slot this node no longer has a block for.
+var ErrTransactionPredatesHistory = errors.New("transaction predates this node's history")
+
+// ErrTransactionHistoryUnsupported is returned by HistoricalCoverage
+// if the node runs a solana-core version that cannot serve getTransaction
+// (i.e. older than v1.7).
+var ErrTransactionHistoryUnsupported = errors.New("node cannot serve transaction history")
+
+// HistoricalCoverage returns the lowest slot for which this node can serve
+// blocks and transactions; anything older has been purged from its ledger.
+// It combines the node version (see GetVersion) and its first available block
+// (see GetFirstAvailableBlock): ErrTransactionHistoryUnsupported is returned
+// if the version of the node does not support getTransaction.
+func (cl *Client) HistoricalCoverage(ctx context.Context) (firstSlot uint64, err error) {
+	version, err := cl.GetVersion(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("historical coverage: get version: %w", err)
+	}
+	if version != nil && !servesTransactionHistory(version.SolanaCore) {
+		return 0, fmt.Errorf("%w: solana-core %s is older than v1.7", ErrTransactionHistoryUnsupported, version.SolanaCore)
+	}
+	firstSlot, err = cl.GetFirstAvailableBlock(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("historical coverage: get first available block: %w", err)
+	}
+	return firstSlot, nil
+}
+
+// servesTransactionHistory reports whether the provided solana-core version
+// supports getTransaction; unparsable versions are assumed to support it.
+func servesTransactionHistory(solanaCore string) bool {
+	parts := strings.SplitN(solanaCore, ".", 3)
+	if len(parts) < 2 {
+		return true
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return true
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return true
+	}
+	return major > 1 || (major == 1 && minor >= 7)
+}
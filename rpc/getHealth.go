@@ -16,6 +16,10 @@ package rpc
 
 import (
 	"context"
+	stdjson "encoding/json"
+	"errors"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
 )
 
 // GetHealth returns the current health of the node.
@@ -34,3 +38,52 @@ func (cl *Client) GetHealth(ctx context.Context) (out string, err error) {
 }
 
 const HealthOk = "ok"
+
+// jsonrpc error code returned by getHealth when the node is unhealthy.
+const nodeUnhealthyCode = -32005
+
+// Ready reports whether the node is ready to serve requests:
+// it returns true when the node is healthy, or when it is behind
+// by no more than maxSlotsBehind slots.
+// A node that is unhealthy without reporting how many slots it is behind
+// is considered not ready.
+// Errors other than the node being unhealthy are returned as is.
+func (cl *Client) Ready(ctx context.Context, maxSlotsBehind uint64) (bool, error) {
+	_, err := cl.GetHealth(ctx)
+	if err == nil {
+		return true, nil
+	}
+	var rpcErr *jsonrpc.RPCError
+	if !errors.As(err, &rpcErr) || rpcErr.Code != nodeUnhealthyCode {
+		return false, err
+	}
+	behind, ok := numSlotsBehind(rpcErr.Data)
+	if !ok {
+		return false, nil
+	}
+	return behind <= maxSlotsBehind, nil
+}
+
+// numSlotsBehind extracts the `numSlotsBehind` value from the data
+// of a node-unhealthy error, e.g. `{"numSlotsBehind":42}`.
+func numSlotsBehind(data interface{}) (uint64, bool) {
+	obj, ok := data.(map[string]interface{})
+	if !ok {
+		return 0, false
+	}
+	switch v := obj["numSlotsBehind"].(type) {
+	case float64:
+		if v < 0 {
+			return 0, false
+		}
+		return uint64(v), true
+	case stdjson.Number:
+		n, err := v.Int64()
+		if err != nil || n < 0 {
+			return 0, false
+		}
+		return uint64(n), true
+	default:
+		return 0, false
+	}
+}
@@ -18,6 +18,8 @@ package rpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/gagliardetto/solana-go"
 )
@@ -48,3 +50,50 @@ type LatestBlockhashResult struct {
 	Blockhash            solana.Hash `json:"blockhash"`
 	LastValidBlockHeight uint64      `json:"lastValidBlockHeight"` // Slot.
 }
+
+// BlockhashExpiryThreshold is the minimum number of blocks a blockhash
+// returned by GetValidBlockhash must remain valid for.
+const BlockhashExpiryThreshold = 20
+
+// ErrBlockhashExpiring is returned by GetValidBlockhash when the node
+// keeps returning a blockhash that is invalid or about to expire.
+var ErrBlockhashExpiring = errors.New("blockhash is invalid or about to expire")
+
+// GetValidBlockhash returns the latest blockhash and its last valid block height,
+// after checking with `isBlockhashValid` that the blockhash is still valid
+// and that it will remain so for at least BlockhashExpiryThreshold blocks.
+// If that is not the case, the blockhash is fetched again once.
+func (cl *Client) GetValidBlockhash(
+	ctx context.Context,
+	commitment CommitmentType, // optional
+) (solana.Hash, uint64, error) {
+	var lastErr error
+	for attempt := 0; attempt < 2; attempt++ {
+		latest, err := cl.GetLatestBlockhash(ctx, commitment)
+		if err != nil {
+			return solana.Hash{}, 0, err
+		}
+		if latest == nil || latest.Value == nil {
+			return solana.Hash{}, 0, ErrNotFound
+		}
+		valid, err := cl.IsBlockhashValid(ctx, latest.Value.Blockhash, commitment)
+		if err != nil {
+			return solana.Hash{}, 0, err
+		}
+		height, err := cl.GetBlockHeight(ctx, commitment)
+		if err != nil {
+			return solana.Hash{}, 0, err
+		}
+		if valid.Value && latest.Value.LastValidBlockHeight >= height+BlockhashExpiryThreshold {
+			return latest.Value.Blockhash, latest.Value.LastValidBlockHeight, nil
+		}
+		lastErr = fmt.Errorf(
+			"%w: blockhash %s, last valid block height %d, current block height %d",
+			ErrBlockhashExpiring,
+			latest.Value.Blockhash,
+			latest.Value.LastValidBlockHeight,
+			height,
+		)
+	}
+	return solana.Hash{}, 0, lastErr
+}
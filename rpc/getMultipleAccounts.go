@@ -17,6 +17,7 @@ package rpc
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/gagliardetto/solana-go"
 )
@@ -40,11 +41,49 @@ func (cl *Client) GetMultipleAccounts(
 
 type GetMultipleAccountsOpts GetAccountInfoOpts
 
+// MaxGetMultipleAccounts is the maximum number of accounts
+// that can be requested with one `getMultipleAccounts` call.
+const MaxGetMultipleAccounts = 100
+
 // GetMultipleAccountsWithOpts returns the account information for a list of Pubkeys.
+// Lists longer than MaxGetMultipleAccounts are requested in chunks,
+// whose results are merged in order; the context is the one of the first chunk.
+// The value of the accounts that don't exist is nil.
 func (cl *Client) GetMultipleAccountsWithOpts(
 	ctx context.Context,
 	accounts []solana.PublicKey,
 	opts *GetMultipleAccountsOpts,
+) (out *GetMultipleAccountsResult, err error) {
+	if len(accounts) <= MaxGetMultipleAccounts {
+		return cl.getMultipleAccounts(ctx, accounts, opts)
+	}
+	for start := 0; start < len(accounts); start += MaxGetMultipleAccounts {
+		end := start + MaxGetMultipleAccounts
+		if end > len(accounts) {
+			end = len(accounts)
+		}
+		chunk, err := cl.getMultipleAccounts(ctx, accounts[start:end], opts)
+		if err != nil {
+			return nil, fmt.Errorf("accounts %d to %d: %w", start, end, err)
+		}
+		if len(chunk.Value) != end-start {
+			return nil, fmt.Errorf("accounts %d to %d: expected %d results, got %d", start, end, end-start, len(chunk.Value))
+		}
+		if out == nil {
+			out = &GetMultipleAccountsResult{
+				RPCContext: chunk.RPCContext,
+				Value:      make([]*Account, 0, len(accounts)),
+			}
+		}
+		out.Value = append(out.Value, chunk.Value...)
+	}
+	return out, nil
+}
+
+func (cl *Client) getMultipleAccounts(
+	ctx context.Context,
+	accounts []solana.PublicKey,
+	opts *GetMultipleAccountsOpts,
 ) (out *GetMultipleAccountsResult, err error) {
 	params := []interface{}{accounts}
 
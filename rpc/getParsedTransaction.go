@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"context"
+	stdjson "encoding/json"
 	"fmt"
 
 	bin "github.com/gagliardetto/binary"
@@ -56,6 +57,8 @@ func (wrap *InstructionInfoEnvelope) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
+	wrap.raw = append(wrap.raw[:0], data...)
+
 	firstChar := data[0]
 
 	switch firstChar {
@@ -80,6 +83,23 @@ func (wrap *InstructionInfoEnvelope) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// Raw returns the `parsed` field exactly as it was received from the RPC node.
+func (wrap *InstructionInfoEnvelope) Raw() stdjson.RawMessage {
+	return wrap.raw
+}
+
+// AsInstructionInfo returns the parsed instruction info,
+// or nil if the `parsed` field was not a JSON object.
+func (wrap *InstructionInfoEnvelope) AsInstructionInfo() *InstructionInfo {
+	return wrap.asInstructionInfo
+}
+
+// AsString returns the `parsed` field if it was a string
+// (e.g. the text of a memo instruction).
+func (wrap *InstructionInfoEnvelope) AsString() string {
+	return wrap.asString
+}
+
 func (obj GetParsedTransactionResult) MarshalWithEncoder(encoder *bin.Encoder) (err error) {
 	err = encoder.WriteUint64(obj.Slot, bin.LE)
 	if err != nil {
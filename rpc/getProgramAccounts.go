@@ -48,6 +48,9 @@ func (cl *Client) GetProgramAccountsWithOpts(
 			obj["commitment"] = string(opts.Commitment)
 		}
 		if len(opts.Filters) != 0 {
+			if err := ValidateRPCFilters(opts.Filters); err != nil {
+				return nil, err
+			}
 			obj["filters"] = opts.Filters
 		}
 		if opts.Encoding != "" {
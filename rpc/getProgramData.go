@@ -0,0 +1,104 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// ErrProgramNotUpgradeable is returned by GetProgramData
+// for programs that are not owned by the BPF upgradeable loader.
+var ErrProgramNotUpgradeable = errors.New("program is not upgradeable")
+
+type ProgramData struct {
+	// Address of the ProgramData account.
+	Address solana.PublicKey
+
+	// Slot in which the program was last deployed or upgraded.
+	Slot uint64
+
+	// Authority that can upgrade the program;
+	// nil if the program is immutable.
+	UpgradeAuthority *solana.PublicKey
+
+	// The program's executable data.
+	Data []byte
+}
+
+// Variants of the BPF upgradeable loader account state.
+const (
+	upgradeableLoaderStateUninitialized uint32 = iota
+	upgradeableLoaderStateBuffer
+	upgradeableLoaderStateProgram
+	upgradeableLoaderStateProgramData
+)
+
+// Size of the metadata that precedes the program bytes in a ProgramData account:
+// the state variant, the slot and the optional upgrade authority.
+const programDataMetadataSize = 4 + 8 + 1 + solana.PublicKeyLength
+
+// GetProgramData resolves and decodes the ProgramData account of
+// the provided program deployed with the BPF upgradeable loader.
+// If the program was deployed with another loader, ErrProgramNotUpgradeable is returned.
+func (cl *Client) GetProgramData(
+	ctx context.Context,
+	programID solana.PublicKey,
+) (*ProgramData, error) {
+	program, err := cl.GetAccountInfo(ctx, programID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get program account: %w", err)
+	}
+	if !program.Value.Owner.Equals(solana.BPFLoaderUpgradeableProgramID) {
+		return nil, fmt.Errorf("%s is owned by %s: %w", programID, program.Value.Owner, ErrProgramNotUpgradeable)
+	}
+	data := program.Value.Data.GetBinary()
+	if len(data) < 4+solana.PublicKeyLength ||
+		binary.LittleEndian.Uint32(data) != upgradeableLoaderStateProgram {
+		return nil, fmt.Errorf("%s is not an upgradeable program account", programID)
+	}
+	programDataAddress := solana.PublicKeyFromBytes(data[4 : 4+solana.PublicKeyLength])
+
+	programData, err := cl.GetAccountInfo(ctx, programDataAddress)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get program data account: %w", err)
+	}
+	return decodeProgramData(programDataAddress, programData.Value.Data.GetBinary())
+}
+
+func decodeProgramData(address solana.PublicKey, data []byte) (*ProgramData, error) {
+	if len(data) < programDataMetadataSize ||
+		binary.LittleEndian.Uint32(data) != upgradeableLoaderStateProgramData {
+		return nil, fmt.Errorf("%s is not a program data account", address)
+	}
+	out := &ProgramData{
+		Address: address,
+		Slot:    binary.LittleEndian.Uint64(data[4:12]),
+		Data:    data[programDataMetadataSize:],
+	}
+	switch data[12] {
+	case 0:
+	case 1:
+		authority := solana.PublicKeyFromBytes(data[13:programDataMetadataSize])
+		out.UpgradeAuthority = &authority
+	default:
+		return nil, fmt.Errorf("invalid upgrade authority option tag: %d", data[12])
+	}
+	return out, nil
+}
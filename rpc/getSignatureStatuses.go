@@ -65,7 +65,7 @@ type SignatureStatusesResult struct {
 	Confirmations *uint64 `json:"confirmations"`
 
 	// Error if transaction failed, null if transaction succeeded.
-	Err interface{} `json:"err"`
+	Err *TransactionError `json:"err"`
 
 	// The transaction's cluster confirmation status; either processed, confirmed, or finalized.
 	ConfirmationStatus ConfirmationStatusType `json:"confirmationStatus"`
@@ -81,3 +81,25 @@ const (
 	ConfirmationStatusConfirmed ConfirmationStatusType = "confirmed"
 	ConfirmationStatusFinalized ConfirmationStatusType = "finalized"
 )
+
+// GetTransactionSlot returns the slot in which the provided transaction was confirmed,
+// or nil if the transaction is not found or has only been processed so far.
+// The whole ledger is searched, not only the recent status cache.
+func (cl *Client) GetTransactionSlot(
+	ctx context.Context,
+	sig solana.Signature,
+) (*uint64, error) {
+	out, err := cl.GetSignatureStatuses(ctx, true, sig)
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Value) == 0 || out.Value[0] == nil {
+		return nil, nil
+	}
+	status := out.Value[0]
+	if status.ConfirmationStatus == ConfirmationStatusProcessed {
+		return nil, nil
+	}
+	slot := status.Slot
+	return &slot, nil
+}
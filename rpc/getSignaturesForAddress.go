@@ -38,6 +38,11 @@ type GetSignaturesForAddressOpts struct {
 	// The minimum slot that the request can be evaluated at.
 	// This parameter is optional.
 	MinContextSlot *uint64
+
+	// (optional) Maximum number of signatures returned by GetAllSignaturesForAddress
+	// across all pages; zero means no maximum.
+	// It is not sent to the node.
+	MaxResults int `json:"-"`
 }
 
 // GetSignaturesForAddress returns confirmed signatures for transactions
@@ -94,3 +99,44 @@ func (cl *Client) GetSignaturesForAddressWithOpts(
 	err = cl.rpcClient.CallForInto(ctx, &out, "getSignaturesForAddress", params)
 	return
 }
+
+// GetAllSignaturesForAddress is like GetSignaturesForAddressWithOpts,
+// but pages backwards in time (using the `before` cursor) until there are
+// no more signatures, or until opts.MaxResults signatures have been fetched.
+// opts.Limit, if set, is the size of each page.
+func (cl *Client) GetAllSignaturesForAddress(
+	ctx context.Context,
+	address solana.PublicKey,
+	opts *GetSignaturesForAddressOpts,
+) ([]*TransactionSignature, error) {
+	pageOpts := GetSignaturesForAddressOpts{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+	maxResults := pageOpts.MaxResults
+
+	var out []*TransactionSignature
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if maxResults > 0 {
+			remaining := maxResults - len(out)
+			if pageOpts.Limit == nil || *pageOpts.Limit > remaining {
+				pageOpts.Limit = &remaining
+			}
+		}
+		page, err := cl.GetSignaturesForAddressWithOpts(ctx, address, &pageOpts)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, page...)
+		if maxResults > 0 && len(out) >= maxResults {
+			return out[:maxResults], nil
+		}
+		if len(page) == 0 {
+			return out, nil
+		}
+		pageOpts.Before = page[len(page)-1].Signature
+	}
+}
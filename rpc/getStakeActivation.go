@@ -16,11 +16,19 @@ package rpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/stake/activation"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
 )
 
 // GetStakeActivation returns epoch activation information for a stake account.
+//
+// The getStakeActivation method has been removed from newer RPC nodes;
+// when the node reports it as not found, the activation is computed locally
+// from the stake account, the epoch info and the stake history sysvar.
 func (cl *Client) GetStakeActivation(
 	ctx context.Context,
 	// Pubkey of stake account to query
@@ -46,9 +54,95 @@ func (cl *Client) GetStakeActivation(
 		}
 	}
 	err = cl.rpcClient.CallForInto(ctx, &out, "getStakeActivation", params)
+	if isMethodNotFoundError(err) {
+		return cl.computeStakeActivation(ctx, account, commitment, epoch)
+	}
 	return
 }
 
+// jsonrpc error code returned for methods that the node does not serve.
+const methodNotFoundCode = -32601
+
+func isMethodNotFoundError(err error) bool {
+	var rpcErr *jsonrpc.RPCError
+	return errors.As(err, &rpcErr) && rpcErr.Code == methodNotFoundCode
+}
+
+// computeStakeActivation computes the stake activation the same way the
+// getStakeActivation RPC method does.
+func (cl *Client) computeStakeActivation(
+	ctx context.Context,
+	account solana.PublicKey,
+	commitment CommitmentType,
+	epoch *uint64,
+) (*GetStakeActivationResult, error) {
+	stakeAccount, err := cl.GetAccountInfoWithOpts(ctx, account, &GetAccountInfoOpts{Commitment: commitment})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get stake account: %w", err)
+	}
+	if !stakeAccount.Value.Owner.Equals(solana.StakeProgramID) {
+		return nil, fmt.Errorf("account %s is not a stake account", account)
+	}
+	state, err := activation.DecodeStakeState(stakeAccount.Value.Data.GetBinary())
+	if err != nil {
+		return nil, fmt.Errorf("invalid stake account: %w", err)
+	}
+	if state.Meta == nil {
+		return nil, errors.New("stake account is not initialized")
+	}
+	lamports := stakeAccount.Value.Lamports
+
+	if state.Stake == nil {
+		return &GetStakeActivationResult{
+			State:    ActivationStateInactive,
+			Active:   0,
+			Inactive: activation.InactiveStake(lamports, 0, state.Meta.RentExemptReserve),
+		}, nil
+	}
+
+	var targetEpoch uint64
+	if epoch != nil {
+		targetEpoch = *epoch
+	} else {
+		epochInfo, err := cl.GetEpochInfo(ctx, commitment)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get epoch info: %w", err)
+		}
+		targetEpoch = epochInfo.Epoch
+	}
+
+	historyAccount, err := cl.GetAccountInfoWithOpts(ctx, solana.SysVarStakeHistoryPubkey, &GetAccountInfoOpts{Commitment: commitment})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get stake history: %w", err)
+	}
+	history, err := activation.DecodeStakeHistory(historyAccount.Value.Data.GetBinary())
+	if err != nil {
+		return nil, err
+	}
+
+	effective, activating, deactivating := activation.Progress(
+		state.Stake.Delegation,
+		targetEpoch,
+		history.ClusterStake,
+	)
+
+	out := &GetStakeActivationResult{
+		Active:   effective,
+		Inactive: activation.InactiveStake(lamports, effective, state.Meta.RentExemptReserve),
+	}
+	switch {
+	case deactivating > 0:
+		out.State = ActivationStateDeactivating
+	case activating > 0:
+		out.State = ActivationStateActivating
+	case effective > 0:
+		out.State = ActivationStateActive
+	default:
+		out.State = ActivationStateInactive
+	}
+	return out, nil
+}
+
 type GetStakeActivationResult struct {
 	// The stake account's activation state, one of: active, inactive, activating, deactivating.
 	State ActivationStateType `json:"state"`
@@ -44,6 +44,51 @@ func (cl *Client) GetSupplyWithOpts(
 	return
 }
 
+// GetSupplyTotals returns the total, circulating and non-circulating supply,
+// without the list of non-circulating accounts (which on mainnet is large):
+// the list is excluded from the response, and never decoded even if the node returns it.
+// The NonCirculatingAccounts field of the result is always empty.
+func (cl *Client) GetSupplyTotals(
+	ctx context.Context,
+	commitment CommitmentType, // optional
+) (out *GetSupplyResult, err error) {
+	obj := M{
+		"commitment":                        CommitmentConfirmed,
+		"excludeNonCirculatingAccountsList": true,
+	}
+	if commitment != "" {
+		obj["commitment"] = commitment
+	}
+
+	var totals *struct {
+		RPCContext
+		Value *struct {
+			Total          uint64 `json:"total"`
+			Circulating    uint64 `json:"circulating"`
+			NonCirculating uint64 `json:"nonCirculating"`
+		} `json:"value"`
+	}
+	err = cl.rpcClient.CallForInto(ctx, &totals, "getSupply", []interface{}{obj})
+	if err != nil {
+		return nil, err
+	}
+	if totals == nil {
+		return nil, nil
+	}
+	out = &GetSupplyResult{
+		RPCContext: totals.RPCContext,
+	}
+	if totals.Value != nil {
+		out.Value = &SupplyResult{
+			Total:                  totals.Value.Total,
+			Circulating:            totals.Value.Circulating,
+			NonCirculating:         totals.Value.NonCirculating,
+			NonCirculatingAccounts: []solana.PublicKey{},
+		}
+	}
+	return out, nil
+}
+
 type GetSupplyOpts struct {
 	Commitment CommitmentType `json:"commitment,omitempty"`
 
@@ -16,10 +16,18 @@ package rpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
 
 	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
 )
 
+// ErrNotTokenMint is returned by GetTokenSupply when the queried account is not an SPL token mint.
+var ErrNotTokenMint = errors.New("not an SPL mint")
+
 // GetTokenSupply returns the total supply of an SPL Token type.
 func (cl *Client) GetTokenSupply(
 	ctx context.Context,
@@ -33,10 +41,31 @@ func (cl *Client) GetTokenSupply(
 		)
 	}
 	err = cl.rpcClient.CallForInto(ctx, &out, "getTokenSupply", params)
+	if isNotTokenMintError(err) {
+		return nil, fmt.Errorf("%s: %w", tokenMint, ErrNotTokenMint)
+	}
 	return
 }
 
+func isNotTokenMintError(err error) bool {
+	var rpcErr *jsonrpc.RPCError
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	// The node replies with e.g. `{"code":-32602,"message":"Invalid param: not a Token mint"}`.
+	return rpcErr.Code == -32602 && strings.Contains(rpcErr.Message, "not a Token mint")
+}
+
 type GetTokenSupplyResult struct {
 	RPCContext
 	Value *UiTokenAmount `json:"value"`
 }
+
+// Supply returns the exact total supply of the token, in raw units (ignoring decimals).
+// Returns nil if the result has no value or the amount is not a valid integer.
+func (res *GetTokenSupplyResult) Supply() *big.Int {
+	if res == nil {
+		return nil
+	}
+	return res.Value.RawAmount()
+}
@@ -16,6 +16,8 @@ package rpc
 
 import (
 	"context"
+	stdjson "encoding/json"
+	"errors"
 	"fmt"
 
 	bin "github.com/gagliardetto/binary"
@@ -31,10 +33,21 @@ type GetTransactionOpts struct {
 	// Max transaction version to return in responses.
 	// If the requested block contains a transaction with a higher version, an error will be returned.
 	MaxSupportedTransactionVersion *uint64
+
+	// If true, the coverage of the node (see HistoricalCoverage) is checked
+	// before querying the transaction, and used to explain why it is not found:
+	// ErrTransactionPredatesHistory is returned if the slot of the transaction
+	// is known and below the first available slot; otherwise, the returned ErrNotFound
+	// reports the first available slot.
+	CheckHistoricalCoverage bool
 }
 
 // GetTransaction returns transaction details for a confirmed transaction.
 //
+// With the jsonParsed encoding, the transaction is returned by
+// Transaction.GetJSONParsedTransaction and the meta (with the parsed inner instructions)
+// by ParsedMeta, while Meta is nil; GetParsedTransaction returns the same data.
+//
 // NEW: This method is only available in solana-core v1.7 or newer.
 // Please use `getConfirmedTransaction` for solana-core v1.6
 func (cl *Client) GetTransaction(
@@ -42,6 +55,13 @@ func (cl *Client) GetTransaction(
 	txSig solana.Signature, // transaction signature
 	opts *GetTransactionOpts,
 ) (out *GetTransactionResult, err error) {
+	var firstSlot uint64
+	if opts != nil && opts.CheckHistoricalCoverage {
+		firstSlot, err = cl.HistoricalCoverage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get transaction: %w", err)
+		}
+	}
 	params := []interface{}{txSig}
 	if opts != nil {
 		obj := M{}
@@ -49,8 +69,8 @@ func (cl *Client) GetTransaction(
 			if !solana.IsAnyOfEncodingType(
 				opts.Encoding,
 				// Valid encodings:
-				// solana.EncodingJSON, // TODO
-				// solana.EncodingJSONParsed, // TODO
+				solana.EncodingJSON,
+				solana.EncodingJSONParsed,
 				solana.EncodingBase58,
 				solana.EncodingBase64,
 				solana.EncodingBase64Zstd,
@@ -69,11 +89,27 @@ func (cl *Client) GetTransaction(
 			params = append(params, obj)
 		}
 	}
-	err = cl.rpcClient.CallForInto(ctx, &out, "getTransaction", params)
+	if opts != nil && opts.Encoding == solana.EncodingJSONParsed {
+		var parsed *GetParsedTransactionResult
+		err = cl.rpcClient.CallForInto(ctx, &parsed, "getTransaction", params)
+		if err == nil && parsed != nil {
+			out = &GetTransactionResult{
+				Slot:        parsed.Slot,
+				BlockTime:   parsed.BlockTime,
+				Transaction: &TransactionResultEnvelope{asJSONParsedTransaction: parsed.Transaction},
+				ParsedMeta:  parsed.Meta,
+			}
+		}
+	} else {
+		err = cl.rpcClient.CallForInto(ctx, &out, "getTransaction", params)
+	}
 	if err != nil {
 		return nil, err
 	}
 	if out == nil {
+		if firstSlot > 0 {
+			return nil, cl.explainMissingTransaction(ctx, txSig, firstSlot)
+		}
 		return nil, ErrNotFound
 	}
 	return
@@ -90,20 +126,41 @@ type GetTransactionResult struct {
 
 	Transaction *TransactionResultEnvelope `json:"transaction" bin:"optional"`
 	Meta        *TransactionMeta           `json:"meta,omitempty" bin:"optional"`
+
+	// Set instead of Meta if the requested encoding is `solana.EncodingJSONParsed`.
+	ParsedMeta *ParsedTransactionMeta `json:"parsedMeta,omitempty"`
+}
+
+// explainMissingTransaction returns the error explaining why the transaction
+// was not found by getTransaction on a node whose history starts at firstSlot.
+func (cl *Client) explainMissingTransaction(ctx context.Context, txSig solana.Signature, firstSlot uint64) error {
+	slot, err := cl.GetTransactionSlot(ctx, txSig)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("get transaction: get slot: %w", err)
+	}
+	if slot != nil && *slot < firstSlot {
+		return fmt.Errorf("%w: transaction slot %d, first available slot %d", ErrTransactionPredatesHistory, *slot, firstSlot)
+	}
+	return fmt.Errorf("%w: the transaction may predate this node's history, which starts at slot %d", ErrNotFound, firstSlot)
 }
 
 // TransactionResultEnvelope will contain a *CompiledTransaction if the requested encoding is `solana.EncodingJSON`
 // (which is also the default when the encoding is not specified),
+// a *ParsedTransaction if it is `solana.EncodingJSONParsed`,
 // or a `solana.Data` in case of EncodingBase58, EncodingBase64.
 type TransactionResultEnvelope struct {
-	asDecodedBinary     solana.Data
-	asParsedTransaction *CompiledTransaction
+	asDecodedBinary         solana.Data
+	asParsedTransaction     *CompiledTransaction
+	asJSONParsedTransaction *ParsedTransaction
 }
 
 func (wrap TransactionResultEnvelope) MarshalJSON() ([]byte, error) {
 	if wrap.asParsedTransaction != nil {
 		return json.Marshal(wrap.asParsedTransaction)
 	}
+	if wrap.asJSONParsedTransaction != nil {
+		return json.Marshal(wrap.asJSONParsedTransaction)
+	}
 	return json.Marshal(wrap.asDecodedBinary)
 }
 
@@ -128,6 +185,9 @@ func (wrap *TransactionResultEnvelope) UnmarshalJSON(data []byte) error {
 	case '{':
 		// It's JSON, most likely.
 		{
+			if isJSONParsedTransaction(data) {
+				return json.Unmarshal(data, &wrap.asJSONParsedTransaction)
+			}
 			return json.Unmarshal(data, &wrap.asParsedTransaction)
 		}
 	default:
@@ -137,12 +197,53 @@ func (wrap *TransactionResultEnvelope) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// isJSONParsedTransaction reports whether the provided JSON transaction
+// has the jsonParsed layout, where the account keys are objects instead of strings.
+func isJSONParsedTransaction(data []byte) bool {
+	var probe struct {
+		Message struct {
+			AccountKeys []stdjson.RawMessage `json:"accountKeys"`
+		} `json:"message"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return false
+	}
+	keys := probe.Message.AccountKeys
+	return len(keys) > 0 && len(keys[0]) > 0 && keys[0][0] == '{'
+}
+
 // GetBinary returns the decoded bytes if the encoding is
 // "base58", "base64".
 func (dt *TransactionResultEnvelope) GetBinary() []byte {
 	return dt.asDecodedBinary.Content
 }
 
+// GetTransaction decodes the transaction when the data encoding
+// is "base58", "base64" or "base64+zstd".
+func (dt *TransactionResultEnvelope) GetTransaction() (*solana.Transaction, error) {
+	if dt.asParsedTransaction != nil {
+		return nil, errors.New("transaction is JSON-encoded; use GetParsedTransaction")
+	}
+	if dt.asJSONParsedTransaction != nil {
+		return nil, errors.New("transaction is jsonParsed-encoded; use GetJSONParsedTransaction")
+	}
+	tx := new(solana.Transaction)
+	err := tx.UnmarshalWithDecoder(bin.NewBinDecoder(dt.GetBinary()))
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// MustGetTransaction is like GetTransaction, but panics if the transaction cannot be decoded.
+func (dt *TransactionResultEnvelope) MustGetTransaction() *solana.Transaction {
+	tx, err := dt.GetTransaction()
+	if err != nil {
+		panic(err)
+	}
+	return tx
+}
+
 func (dt *TransactionResultEnvelope) GetData() solana.Data {
 	return dt.asDecodedBinary
 }
@@ -153,6 +254,12 @@ func (dt *TransactionResultEnvelope) GetParsedTransaction() *CompiledTransaction
 	return dt.asParsedTransaction
 }
 
+// GetJSONParsedTransaction returns a *ParsedTransaction when the data
+// encoding is EncodingJSONParsed.
+func (dt *TransactionResultEnvelope) GetJSONParsedTransaction() *ParsedTransaction {
+	return dt.asJSONParsedTransaction
+}
+
 func (obj TransactionResultEnvelope) MarshalWithEncoder(encoder *bin.Encoder) (err error) {
 	return encoder.Encode(obj.asDecodedBinary)
 }
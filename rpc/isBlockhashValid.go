@@ -17,10 +17,44 @@ func (cl *Client) IsBlockhashValid(
 
 	// Commitment requirement. Optional.
 	commitment CommitmentType,
+) (out *IsValidBlockhashResult, err error) {
+	return cl.IsBlockhashValidWithOpts(
+		ctx,
+		blockHash,
+		&IsBlockhashValidOpts{
+			Commitment: commitment,
+		},
+	)
+}
+
+type IsBlockhashValidOpts struct {
+	// Commitment requirement. Optional.
+	Commitment CommitmentType
+
+	// The minimum slot that the request can be evaluated at.
+	// This parameter is optional.
+	MinContextSlot *uint64
+}
+
+// IsBlockhashValidWithOpts is like IsBlockhashValid, with the provided options.
+// The slot at which the blockhash was evaluated is in out.Context.Slot.
+func (cl *Client) IsBlockhashValidWithOpts(
+	ctx context.Context,
+	blockHash solana.Hash,
+	opts *IsBlockhashValidOpts,
 ) (out *IsValidBlockhashResult, err error) {
 	params := []interface{}{blockHash}
-	if commitment != "" {
-		params = append(params, M{"commitment": string(commitment)})
+	if opts != nil {
+		obj := M{}
+		if opts.Commitment != "" {
+			obj["commitment"] = string(opts.Commitment)
+		}
+		if opts.MinContextSlot != nil {
+			obj["minContextSlot"] = *opts.MinContextSlot
+		}
+		if len(obj) > 0 {
+			params = append(params, obj)
+		}
 	}
 
 	err = cl.rpcClient.CallForInto(ctx, &out, "isBlockhashValid", params)
@@ -472,9 +472,35 @@ func (client *rpcClient) newRequest(ctx context.Context, req interface{}) (*http
 		request.Header.Set(k, v)
 	}
 
+	if id, ok := RequestIDFromContext(ctx); ok {
+		request.Header.Set(RequestIDHeader, id)
+	}
+
 	return request, nil
 }
 
+// RequestIDHeader is the HTTP header used to send the request id
+// attached to the context with WithRequestID.
+const RequestIDHeader = "X-Request-Id"
+
+type requestIDContextKey struct{}
+
+// WithRequestID returns a copy of ctx that carries the provided request id;
+// requests made with the returned context will have the id set
+// in the RequestIDHeader HTTP header.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, id)
+}
+
+// RequestIDFromContext returns the request id attached to ctx, if any.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	id, ok := ctx.Value(requestIDContextKey{}).(string)
+	return id, ok && id != ""
+}
+
 func (client *rpcClient) doCall(
 	ctx context.Context,
 	RPCRequest *RPCRequest,
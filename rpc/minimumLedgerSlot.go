@@ -18,9 +18,11 @@ import (
 	"context"
 )
 
-// MinimumLedgerSlot returns the lowest slot that the node
-// has information about in its ledger. This value may increase
-// over time if the node is configured to purge older ledger data.
+// MinimumLedgerSlot returns the lowest slot that the node has information about in its ledger.
+// This value may increase over time if the node is configured to purge older ledger data.
+//
+// NOTE: the slot may be skipped or not yet confirmed; to find
+// the lowest block that can be fetched from the node, use GetFirstAvailableBlock.
 func (cl *Client) MinimumLedgerSlot(ctx context.Context) (out uint64, err error) {
 	err = cl.rpcClient.CallForInto(ctx, &out, "minimumLedgerSlot", nil)
 	return
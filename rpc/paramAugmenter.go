@@ -0,0 +1,68 @@
+// Copyright 2021 github.com/gagliardetto
+// This file has been modified by github.com/gagliardetto
+//
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+
+package rpc
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// ParamAugmenter can append to, or modify, the params of a request
+// before it is sent; it must return the params to send.
+type ParamAugmenter func(method string, params []interface{}) []interface{}
+
+// WithParamAugmenter returns a new client that shares the underlying RPC client of cl,
+// and passes the params of every request through the provided augmenter before sending it.
+// This is an escape hatch for provider-specific params
+// on the methods modeled by this package.
+func (cl *Client) WithParamAugmenter(augmenter ParamAugmenter) *Client {
+	if augmenter == nil {
+		return cl
+	}
+	return &Client{
+		rpcURL: cl.rpcURL,
+		rpcClient: &paramAugmenterClient{
+			rpcClient: cl.rpcClient,
+			augmenter: augmenter,
+		},
+	}
+}
+
+type paramAugmenterClient struct {
+	rpcClient JSONRPCClient
+	augmenter ParamAugmenter
+}
+
+func (c *paramAugmenterClient) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	return c.rpcClient.CallForInto(ctx, out, method, c.augmenter(method, params))
+}
+
+func (c *paramAugmenterClient) CallWithCallback(
+	ctx context.Context,
+	method string,
+	params []interface{},
+	callback func(*http.Request, *http.Response) error,
+) error {
+	return c.rpcClient.CallWithCallback(ctx, method, c.augmenter(method, params), callback)
+}
+
+func (c *paramAugmenterClient) Close() error {
+	if closer, ok := c.rpcClient.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	stdjson "encoding/json"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// ParsedAccountData is the envelope of account data returned
+// with the "jsonParsed" encoding.
+type ParsedAccountData struct {
+	// Name of the program that owns the account, e.g. "stake" or "vote".
+	Program string `json:"program"`
+	// Program-specific parsed state.
+	Parsed stdjson.RawMessage `json:"parsed"`
+	// Size of the account data, in bytes.
+	Space uint64 `json:"space"`
+}
+
+// GetParsedAccountData returns the envelope of "jsonParsed" account data;
+// it returns an error if the data was not returned as parsed JSON
+// (e.g. the node doesn't have a parser for the owner program, and fell back to base64).
+func (dt *DataBytesOrJSON) GetParsedAccountData() (*ParsedAccountData, error) {
+	if dt == nil || dt.asJSON == nil {
+		return nil, fmt.Errorf("account data is not jsonParsed")
+	}
+	var out ParsedAccountData
+	if err := json.Unmarshal(dt.asJSON, &out); err != nil {
+		return nil, fmt.Errorf("unable to decode parsed account data: %w", err)
+	}
+	return &out, nil
+}
+
+// GetParsedStakeAccount decodes "jsonParsed" data of a stake account.
+func (dt *DataBytesOrJSON) GetParsedStakeAccount() (*ParsedStakeAccount, error) {
+	var out ParsedStakeAccount
+	if err := dt.decodeParsed("stake", &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetParsedVoteAccount decodes "jsonParsed" data of a vote account.
+func (dt *DataBytesOrJSON) GetParsedVoteAccount() (*ParsedVoteAccount, error) {
+	var out ParsedVoteAccount
+	if err := dt.decodeParsed("vote", &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (dt *DataBytesOrJSON) decodeParsed(program string, out interface{}) error {
+	data, err := dt.GetParsedAccountData()
+	if err != nil {
+		return err
+	}
+	if data.Program != program {
+		return fmt.Errorf("expected parsed %s account, got %q", program, data.Program)
+	}
+	if err := json.Unmarshal(data.Parsed, out); err != nil {
+		return fmt.Errorf("unable to decode parsed %s account: %w", program, err)
+	}
+	return nil
+}
+
+type ParsedStakeAccount struct {
+	// One of "uninitialized", "initialized", "delegated", "rewardsPool".
+	Type string                  `json:"type"`
+	Info *ParsedStakeAccountInfo `json:"info,omitempty"`
+}
+
+type ParsedStakeAccountInfo struct {
+	Meta ParsedStakeMeta `json:"meta"`
+	// nil if the stake is not delegated.
+	Stake *ParsedStake `json:"stake,omitempty"`
+}
+
+type ParsedStakeMeta struct {
+	RentExemptReserve uint64                `json:"rentExemptReserve,string"`
+	Authorized        ParsedStakeAuthorized `json:"authorized"`
+	Lockup            ParsedStakeLockup     `json:"lockup"`
+}
+
+type ParsedStakeAuthorized struct {
+	Staker     solana.PublicKey `json:"staker"`
+	Withdrawer solana.PublicKey `json:"withdrawer"`
+}
+
+type ParsedStakeLockup struct {
+	UnixTimestamp int64            `json:"unixTimestamp"`
+	Epoch         uint64           `json:"epoch"`
+	Custodian     solana.PublicKey `json:"custodian"`
+}
+
+type ParsedStake struct {
+	Delegation      ParsedStakeDelegation `json:"delegation"`
+	CreditsObserved uint64                `json:"creditsObserved"`
+}
+
+type ParsedStakeDelegation struct {
+	Voter              solana.PublicKey `json:"voter"`
+	Stake              uint64           `json:"stake,string"`
+	ActivationEpoch    uint64           `json:"activationEpoch,string"`
+	DeactivationEpoch  uint64           `json:"deactivationEpoch,string"`
+	WarmupCooldownRate float64          `json:"warmupCooldownRate"`
+}
+
+type ParsedVoteAccount struct {
+	// Always "vote".
+	Type string                 `json:"type"`
+	Info *ParsedVoteAccountInfo `json:"info,omitempty"`
+}
+
+type ParsedVoteAccountInfo struct {
+	NodePubkey           solana.PublicKey        `json:"nodePubkey"`
+	AuthorizedWithdrawer solana.PublicKey        `json:"authorizedWithdrawer"`
+	Commission           uint8                   `json:"commission"`
+	Votes                []ParsedVoteLockout     `json:"votes"`
+	RootSlot             *uint64                 `json:"rootSlot"`
+	AuthorizedVoters     []ParsedAuthorizedVoter `json:"authorizedVoters"`
+	PriorVoters          []ParsedPriorVoter      `json:"priorVoters"`
+	EpochCredits         []ParsedEpochCredits    `json:"epochCredits"`
+	LastTimestamp        ParsedVoteTimestamp     `json:"lastTimestamp"`
+}
+
+type ParsedVoteLockout struct {
+	Slot              uint64 `json:"slot"`
+	ConfirmationCount uint32 `json:"confirmationCount"`
+}
+
+type ParsedAuthorizedVoter struct {
+	Epoch           uint64           `json:"epoch"`
+	AuthorizedVoter solana.PublicKey `json:"authorizedVoter"`
+}
+
+type ParsedPriorVoter struct {
+	AuthorizedPubkey            solana.PublicKey `json:"authorizedPubkey"`
+	EpochOfLastAuthorizedSwitch uint64           `json:"epochOfLastAuthorizedSwitch"`
+	TargetEpoch                 uint64           `json:"targetEpoch"`
+}
+
+type ParsedEpochCredits struct {
+	Epoch           uint64 `json:"epoch"`
+	Credits         uint64 `json:"credits,string"`
+	PreviousCredits uint64 `json:"previousCredits,string"`
+}
+
+type ParsedVoteTimestamp struct {
+	Slot      uint64                 `json:"slot"`
+	Timestamp solana.UnixTimeSeconds `json:"timestamp"`
+}
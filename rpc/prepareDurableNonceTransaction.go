@@ -0,0 +1,87 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+)
+
+// ErrNonceAccountNotInitialized is returned by PrepareDurableNonceTransaction
+// when the provided nonce account has not been initialized.
+var ErrNonceAccountNotInitialized = errors.New("nonce account is not initialized")
+
+// State of a nonce account.
+const (
+	nonceStateUninitialized uint32 = iota
+	nonceStateInitialized
+)
+
+// PrepareDurableNonceTransaction fetches the provided nonce account and builds
+// a transaction that uses its stored nonce as the recent blockhash.
+// An AdvanceNonceAccount instruction (signed by nonceAuthority) is prepended
+// to the provided instructions, as required by the runtime.
+// The returned transaction is not signed, and can be signed offline.
+func (cl *Client) PrepareDurableNonceTransaction(
+	ctx context.Context,
+	nonceAccount solana.PublicKey,
+	nonceAuthority solana.PublicKey,
+	feePayer solana.PublicKey,
+	instructions []solana.Instruction,
+) (*solana.Transaction, error) {
+	account, err := cl.GetAccountInfo(ctx, nonceAccount)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get nonce account: %w", err)
+	}
+	if !account.Value.Owner.Equals(solana.SystemProgramID) {
+		return nil, fmt.Errorf("%s is owned by %s, not by the system program", nonceAccount, account.Value.Owner)
+	}
+	nonce, err := decodeNonce(nonceAccount, account.Value.Data.GetBinary())
+	if err != nil {
+		return nil, err
+	}
+
+	advance, err := system.NewAdvanceNonceAccountInstruction(
+		nonceAccount,
+		solana.SysVarRecentBlockHashesPubkey,
+		nonceAuthority,
+	).ValidateAndBuild()
+	if err != nil {
+		return nil, err
+	}
+
+	return solana.NewTransaction(
+		append([]solana.Instruction{advance}, instructions...),
+		nonce,
+		solana.TransactionPayer(feePayer),
+	)
+}
+
+// decodeNonce returns the durable nonce stored in the data of a nonce account.
+func decodeNonce(address solana.PublicKey, data []byte) (solana.Hash, error) {
+	if len(data) != nonceAccountSize {
+		return solana.Hash{}, fmt.Errorf("%s is not a nonce account: data is %d bytes", address, len(data))
+	}
+	// Layout: version (u32), state (u32), authority (32 bytes), nonce (32 bytes), fee calculator (u64).
+	if binary.LittleEndian.Uint32(data[4:8]) != nonceStateInitialized {
+		return solana.Hash{}, fmt.Errorf("%s: %w", address, ErrNonceAccountNotInitialized)
+	}
+	return solana.HashFromBytes(data[40:72]), nil
+}
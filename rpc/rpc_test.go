@@ -29,7 +29,9 @@ import (
 
 type mockJSONRPCServer struct {
 	*httptest.Server
-	body []byte
+	body       []byte
+	header     http.Header
+	requestURI string
 }
 
 func mockJSONRPC(t *testing.T, response interface{}) (mock *mockJSONRPCServer, close func()) {
@@ -38,6 +40,8 @@ func mockJSONRPC(t *testing.T, response interface{}) (mock *mockJSONRPCServer, c
 			var err error
 			mock.body, err = ioutil.ReadAll(req.Body)
 			require.NoError(t, err)
+			mock.header = req.Header.Clone()
+			mock.requestURI = req.RequestURI
 
 			var responseBody []byte
 			if v, ok := response.(stdjson.RawMessage); ok {
@@ -54,6 +58,21 @@ func mockJSONRPC(t *testing.T, response interface{}) (mock *mockJSONRPCServer, c
 	return mock, func() { mock.Close() }
 }
 
+// mockJSONRPCFunc starts a server that responds to each request
+// with the result returned by fn for the decoded request.
+func mockJSONRPCFunc(t *testing.T, fn func(request map[string]interface{}) string) (server *httptest.Server, close func()) {
+	server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		require.NoError(t, err)
+
+		var request map[string]interface{}
+		require.NoError(t, json.Unmarshal(body, &request))
+
+		rw.Write([]byte(fn(request)))
+	}))
+	return server, func() { server.Close() }
+}
+
 func (s *mockJSONRPCServer) RequestBodyAsJSON(t *testing.T) (out string) {
 	return string(s.body)
 }
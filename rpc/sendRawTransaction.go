@@ -19,10 +19,16 @@ package rpc
 import (
 	"context"
 	"encoding/base64"
+	"fmt"
 
 	"github.com/gagliardetto/solana-go"
+	"github.com/mr-tron/base58"
 )
 
+// minRawTransactionSize is the size of the smallest plausible transaction:
+// the signatures count, plus one signature.
+const minRawTransactionSize = 1 + solana.SignatureLength
+
 // SendRawTransaction submits a signed transaction to the cluster for processing.
 // The only difference between this function and SignTransaction is that the latter takes a *solana.Transaction value, as the former takes a transaction in wire format as a byte array
 func (cl *Client) SendRawTransaction(
@@ -47,9 +53,31 @@ func (cl *Client) SendRawTransactionWithOpts(
 	rawTx []byte,
 	opts TransactionOpts,
 ) (signature solana.Signature, err error) {
+	if len(rawTx) < minRawTransactionSize {
+		return solana.Signature{}, fmt.Errorf("raw transaction is too short: expected at least %d bytes, got %d", minRawTransactionSize, len(rawTx))
+	}
+
+	encodedTx, err := encodeTransaction(rawTx, opts.Encoding)
+	if err != nil {
+		return solana.Signature{}, err
+	}
+
 	return cl.SendEncodedTransactionWithOpts(
 		ctx,
-		base64.StdEncoding.EncodeToString(rawTx),
+		encodedTx,
 		opts,
 	)
 }
+
+// encodeTransaction encodes the wire format of a transaction
+// with the provided encoding (base64 if empty).
+func encodeTransaction(rawTx []byte, encoding solana.EncodingType) (string, error) {
+	switch encoding {
+	case "", solana.EncodingBase64:
+		return base64.StdEncoding.EncodeToString(rawTx), nil
+	case solana.EncodingBase58:
+		return base58.Encode(rawTx), nil
+	default:
+		return "", fmt.Errorf("unsupported encoding for transaction: %s", encoding)
+	}
+}
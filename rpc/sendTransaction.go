@@ -18,12 +18,17 @@ package rpc
 
 import (
 	"context"
-	"encoding/base64"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/gagliardetto/solana-go"
 )
 
+// ErrMissingSignatures is returned by SendTransactionWithOpts when some of the
+// required signatures of the transaction are missing or invalid.
+var ErrMissingSignatures = errors.New("missing signatures")
+
 // SendTransaction submits a signed transaction to the cluster for processing.
 func (cl *Client) SendTransaction(
 	ctx context.Context,
@@ -66,19 +71,63 @@ func (cl *Client) SendTransaction(
 // The returned signature is the first signature in the transaction, which is
 // used to identify the transaction (transaction id). This identifier can be
 // easily extracted from the transaction data before submission.
+//
+// An unsigned transaction is not submitted: solana.ErrTransactionNotSigned is returned instead.
+// Unless opts.SkipSignatureVerification is set, all the signatures are verified too,
+// and ErrMissingSignatures is returned with the list of the signers
+// whose signature is missing or invalid.
+//
+// The transaction is encoded with opts.Encoding: base64 (the default) or base58.
 func (cl *Client) SendTransactionWithOpts(
 	ctx context.Context,
 	transaction *solana.Transaction,
 	opts TransactionOpts,
 ) (signature solana.Signature, err error) {
+	if transaction == nil {
+		return solana.Signature{}, fmt.Errorf("send transaction: transaction is nil")
+	}
+	if _, err := transaction.Signature(); err != nil {
+		return solana.Signature{}, fmt.Errorf("send transaction: %w", err)
+	}
+	if !opts.SkipSignatureVerification {
+		if err := transaction.VerifySignatures(); err != nil {
+			missing, findErr := unsignedSigners(transaction)
+			if findErr != nil || len(missing) == 0 {
+				return solana.Signature{}, fmt.Errorf("send transaction: %w", err)
+			}
+			return solana.Signature{}, fmt.Errorf("send transaction: %w: %s", ErrMissingSignatures, strings.Join(missing, ", "))
+		}
+	}
+
 	txData, err := transaction.MarshalBinary()
 	if err != nil {
 		return solana.Signature{}, fmt.Errorf("send transaction: encode transaction: %w", err)
 	}
 
+	encodedTx, err := encodeTransaction(txData, opts.Encoding)
+	if err != nil {
+		return solana.Signature{}, fmt.Errorf("send transaction: %w", err)
+	}
+
 	return cl.SendEncodedTransactionWithOpts(
 		ctx,
-		base64.StdEncoding.EncodeToString(txData),
+		encodedTx,
 		opts,
 	)
 }
+
+// unsignedSigners returns the signers of the transaction
+// whose signature is missing or invalid.
+func unsignedSigners(transaction *solana.Transaction) ([]string, error) {
+	msg, err := transaction.Message.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	var missing []string
+	for i, signer := range transaction.Message.Signers() {
+		if i >= len(transaction.Signatures) || !transaction.Signatures[i].Verify(signer, msg) {
+			missing = append(missing, signer.String())
+		}
+	}
+	return missing, nil
+}
@@ -19,7 +19,10 @@ package rpc
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/gagliardetto/solana-go"
 )
@@ -31,7 +34,7 @@ type SimulateTransactionResponse struct {
 
 type SimulateTransactionResult struct {
 	// Error if transaction failed, null if transaction succeeded.
-	Err interface{} `json:"err,omitempty"`
+	Err *TransactionError `json:"err,omitempty"`
 
 	// Array of log messages the transaction instructions output during execution,
 	// null if simulation failed before the transaction was able to execute
@@ -43,6 +46,15 @@ type SimulateTransactionResult struct {
 
 	// The number of compute budget units consumed during the processing of this transaction.
 	UnitsConsumed *uint64 `json:"unitsConsumed,omitempty"`
+
+	// Inner instructions executed by the transaction;
+	// only returned if SimulateTransactionOpts.InnerInstructions is true.
+	InnerInstructions []InnerInstruction `json:"innerInstructions,omitempty"`
+
+	// The post-simulation state of the accounts requested via
+	// SimulateTransactionOpts.Accounts, keyed by address;
+	// a nil value means the account doesn't exist after the simulation.
+	AccountStates map[solana.PublicKey]*Account `json:"-"`
 }
 
 // SimulateTransaction simulates sending a transaction.
@@ -71,8 +83,16 @@ type SimulateTransactionOpts struct {
 	ReplaceRecentBlockhash bool
 
 	Accounts *SimulateTransactionAccountsOpts
+
+	// If true the response will include the inner instructions
+	// executed by the transaction.
+	InnerInstructions bool
 }
 
+// MaxSimulateTransactionAccounts is the maximum number of addresses
+// that can be requested via SimulateTransactionAccountsOpts.Addresses.
+const MaxSimulateTransactionAccounts = 100
+
 type SimulateTransactionAccountsOpts struct {
 	// (optional) Encoding for returned Account data,
 	// either "base64" (default), "base64+zstd" or "jsonParsed".
@@ -93,6 +113,9 @@ func (cl *Client) SimulateTransactionWithOpts(
 	transaction *solana.Transaction,
 	opts *SimulateTransactionOpts,
 ) (out *SimulateTransactionResponse, err error) {
+	if opts != nil && opts.SigVerify && opts.ReplaceRecentBlockhash {
+		return nil, errors.New("simulate transaction: SigVerify and ReplaceRecentBlockhash cannot be both set")
+	}
 	txData, err := transaction.MarshalBinary()
 	if err != nil {
 		return nil, fmt.Errorf("send transaction: encode transaction: %w", err)
@@ -112,10 +135,23 @@ func (cl *Client) SimulateTransactionWithOpts(
 			obj["replaceRecentBlockhash"] = opts.ReplaceRecentBlockhash
 		}
 		if opts.Accounts != nil {
-			obj["accounts"] = M{
-				"encoding":  opts.Accounts.Encoding,
+			if len(opts.Accounts.Addresses) > MaxSimulateTransactionAccounts {
+				return nil, fmt.Errorf(
+					"too many accounts requested: %d (max %d)",
+					len(opts.Accounts.Addresses),
+					MaxSimulateTransactionAccounts,
+				)
+			}
+			accountsObj := M{
 				"addresses": opts.Accounts.Addresses,
 			}
+			if opts.Accounts.Encoding != "" {
+				accountsObj["encoding"] = opts.Accounts.Encoding
+			}
+			obj["accounts"] = accountsObj
+		}
+		if opts.InnerInstructions {
+			obj["innerInstructions"] = opts.InnerInstructions
 		}
 	}
 
@@ -126,5 +162,127 @@ func (cl *Client) SimulateTransactionWithOpts(
 	}
 
 	err = cl.rpcClient.CallForInto(ctx, &out, "simulateTransaction", params)
+	if err != nil {
+		return nil, err
+	}
+	if opts != nil && opts.Accounts != nil && out != nil && out.Value != nil && out.Value.Accounts != nil {
+		addresses := opts.Accounts.Addresses
+		if len(out.Value.Accounts) != len(addresses) {
+			return nil, fmt.Errorf(
+				"simulate transaction: requested %d accounts, got %d",
+				len(addresses),
+				len(out.Value.Accounts),
+			)
+		}
+		out.Value.AccountStates = make(map[solana.PublicKey]*Account, len(addresses))
+		for i, address := range addresses {
+			out.Value.AccountStates[address] = out.Value.Accounts[i]
+		}
+	}
 	return
 }
+
+// ComputeUnitsConsumed returns the number of compute units consumed by the simulated transaction.
+// Older nodes don't return `unitsConsumed`; in that case the value is recovered
+// from the `consumed X of Y compute units` log lines of the top-level instructions.
+// The returned bool is false if neither is available.
+func (res *SimulateTransactionResult) ComputeUnitsConsumed() (uint64, bool) {
+	if res.UnitsConsumed != nil {
+		return *res.UnitsConsumed, true
+	}
+	return computeUnitsFromLogs(res.Logs)
+}
+
+// computeUnitsFromLogs sums the compute units consumed by the top-level instructions,
+// as reported in the program logs. Units consumed by CPIs are already included
+// in the consumption of the invoking instruction.
+func computeUnitsFromLogs(logs []string) (uint64, bool) {
+	var total uint64
+	var found bool
+	depth := 0
+	for _, line := range logs {
+		fields, ok := runtimeProgramLogFields(line)
+		if !ok {
+			continue
+		}
+		switch {
+		case len(fields) == 4 && fields[2] == "invoke" && isInvokeDepth(fields[3]):
+			depth++
+		case len(fields) == 3 && fields[2] == "success",
+			len(fields) >= 3 && fields[2] == "failed:":
+			depth--
+		case len(fields) == 8 && fields[2] == "consumed" && fields[4] == "of" && fields[6] == "compute" && fields[7] == "units":
+			if depth != 1 {
+				continue
+			}
+			units, err := strconv.ParseUint(fields[3], 10, 64)
+			if err != nil {
+				continue
+			}
+			total += units
+			found = true
+		}
+	}
+	return total, found
+}
+
+// runtimeProgramLogFields returns the fields of a log line written by the runtime
+// about a program, i.e. `Program <program id> ...`; the lines written by the
+// programs themselves (`Program log: ...`, `Program data: ...`, `Program return: ...`)
+// are rejected.
+func runtimeProgramLogFields(line string) ([]string, bool) {
+	if !strings.HasPrefix(line, "Program ") ||
+		strings.HasPrefix(line, "Program log:") ||
+		strings.HasPrefix(line, "Program data:") ||
+		strings.HasPrefix(line, "Program return:") {
+		return nil, false
+	}
+	fields := strings.Fields(line)
+	if len(fields) < 3 {
+		return nil, false
+	}
+	if _, err := solana.PublicKeyFromBase58(fields[1]); err != nil {
+		return nil, false
+	}
+	return fields, true
+}
+
+// isInvokeDepth returns true if s is the `[N]` invocation depth of an invoke log line.
+func isInvokeDepth(s string) bool {
+	if len(s) < 3 || s[0] != '[' || s[len(s)-1] != ']' {
+		return false
+	}
+	_, err := strconv.ParseUint(s[1:len(s)-1], 10, 64)
+	return err == nil
+}
+
+// EstimateComputeUnits simulates the provided transaction (replacing its recent blockhash)
+// and returns the number of compute units it consumed.
+func (cl *Client) EstimateComputeUnits(
+	ctx context.Context,
+	transaction *solana.Transaction,
+	commitment CommitmentType, // optional
+) (uint64, error) {
+	out, err := cl.SimulateTransactionWithOpts(
+		ctx,
+		transaction,
+		&SimulateTransactionOpts{
+			Commitment:             commitment,
+			ReplaceRecentBlockhash: true,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	if out == nil || out.Value == nil {
+		return 0, errors.New("estimate compute units: empty simulation result")
+	}
+	if out.Value.Err != nil {
+		return 0, fmt.Errorf("estimate compute units: simulation failed: %v", out.Value.Err)
+	}
+	units, ok := out.Value.ComputeUnitsConsumed()
+	if !ok {
+		return 0, errors.New("estimate compute units: units consumed not found in simulation result")
+	}
+	return units, nil
+}
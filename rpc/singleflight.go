@@ -0,0 +1,105 @@
+// Copyright 2021 github.com/gagliardetto
+// This file has been modified by github.com/gagliardetto
+//
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+
+package rpc
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// WithSingleFlight returns a new client that shares the underlying RPC client of cl,
+// and coalesces concurrent identical requests (same method and params)
+// into a single upstream call, whose result (or error) is shared among all the callers.
+//
+// The cancellation of the context of one of the callers only makes that caller return;
+// the shared call keeps going for the other callers.
+func (cl *Client) WithSingleFlight() *Client {
+	if _, ok := cl.rpcClient.(*singleFlightClient); ok {
+		return cl
+	}
+	return &Client{
+		rpcURL: cl.rpcURL,
+		rpcClient: &singleFlightClient{
+			rpcClient: cl.rpcClient,
+		},
+	}
+}
+
+type singleFlightClient struct {
+	rpcClient JSONRPCClient
+	group     singleflight.Group
+}
+
+func (c *singleFlightClient) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	key, err := json.Marshal(params)
+	if err != nil {
+		// Can't compute the key; don't coalesce.
+		return c.rpcClient.CallForInto(ctx, out, method, params)
+	}
+
+	ch := c.group.DoChan(method+string(key), func() (interface{}, error) {
+		var raw stdjson.RawMessage
+		err := c.rpcClient.CallForInto(detachedContext{ctx}, &raw, method, params)
+		return raw, err
+	})
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return res.Err
+		}
+		raw := res.Val.(stdjson.RawMessage)
+		if raw == nil {
+			raw = stdjson.RawMessage(`null`)
+		}
+		return json.Unmarshal(raw, out)
+	}
+}
+
+func (c *singleFlightClient) CallWithCallback(
+	ctx context.Context,
+	method string,
+	params []interface{},
+	callback func(*http.Request, *http.Response) error,
+) error {
+	// The callback has access to the raw response, which can't be shared.
+	return c.rpcClient.CallWithCallback(ctx, method, params, callback)
+}
+
+func (c *singleFlightClient) Close() error {
+	if closer, ok := c.rpcClient.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// detachedContext carries the values of the wrapped context,
+// but is never canceled and has no deadline.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (deadline time.Time, ok bool) { return }
+func (detachedContext) Done() <-chan struct{}                   { return nil }
+func (detachedContext) Err() error                              { return nil }
+func (c detachedContext) Value(key interface{}) interface{}     { return c.parent.Value(key) }
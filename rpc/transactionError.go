@@ -0,0 +1,131 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	stdjson "encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// TransactionError is the error of a failed transaction,
+// as found in the `err` field of statuses, metas and simulations;
+// a nil *TransactionError means the transaction succeeded.
+//
+// The RPC encodes it as either the name of the error (e.g. `"AccountInUse"`),
+// or an object with the name as the only key (e.g. `{"InstructionError":[0,{"Custom":1}]}`).
+//
+// https://github.com/solana-labs/solana/blob/master/sdk/src/transaction/error.rs
+type TransactionError struct {
+	// Name of the error, e.g. "InstructionError".
+	Kind string
+
+	// Details of the error, nil if the error has none.
+	Details stdjson.RawMessage
+}
+
+func (e *TransactionError) Error() string {
+	if len(e.Details) == 0 {
+		return e.Kind
+	}
+	return fmt.Sprintf("%s: %s", e.Kind, string(e.Details))
+}
+
+func (e TransactionError) MarshalJSON() ([]byte, error) {
+	if len(e.Details) == 0 {
+		return json.Marshal(e.Kind)
+	}
+	return json.Marshal(map[string]stdjson.RawMessage{e.Kind: e.Details})
+}
+
+func (e *TransactionError) UnmarshalJSON(data []byte) error {
+	var kind string
+	if err := json.Unmarshal(data, &kind); err == nil {
+		*e = TransactionError{Kind: kind}
+		return nil
+	}
+	var obj map[string]stdjson.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return fmt.Errorf("invalid transaction error %s: %w", string(data), err)
+	}
+	if len(obj) != 1 {
+		return fmt.Errorf("invalid transaction error %s: expected exactly one key", string(data))
+	}
+	for k, v := range obj {
+		*e = TransactionError{Kind: k, Details: v}
+	}
+	return nil
+}
+
+// InstructionIndex returns the index of the failed instruction
+// if this is an InstructionError.
+func (e *TransactionError) InstructionIndex() (int, bool) {
+	index, _, ok := e.instructionError()
+	return index, ok
+}
+
+// CustomErrorCode returns the program-specific error code
+// if this is an InstructionError with a Custom error.
+func (e *TransactionError) CustomErrorCode() (uint32, bool) {
+	_, instErr, ok := e.instructionError()
+	if !ok {
+		return 0, false
+	}
+	var custom struct {
+		Custom *uint32
+	}
+	if err := json.Unmarshal(instErr, &custom); err != nil || custom.Custom == nil {
+		return 0, false
+	}
+	return *custom.Custom, true
+}
+
+func (e *TransactionError) instructionError() (int, stdjson.RawMessage, bool) {
+	if e == nil || e.Kind != "InstructionError" {
+		return 0, nil, false
+	}
+	var tuple []stdjson.RawMessage
+	if err := json.Unmarshal(e.Details, &tuple); err != nil || len(tuple) != 2 {
+		return 0, nil, false
+	}
+	var index int
+	if err := json.Unmarshal(tuple[0], &index); err != nil {
+		return 0, nil, false
+	}
+	return index, tuple[1], true
+}
+
+// jsonrpc error code of a transaction that failed the preflight simulation.
+const preflightFailureCode = -32002
+
+// PreflightFailure returns the simulation result carried by the error
+// of a SendTransaction call that failed the preflight checks.
+func PreflightFailure(err error) (*SimulateTransactionResult, bool) {
+	var rpcErr *jsonrpc.RPCError
+	if !errors.As(err, &rpcErr) || rpcErr.Code != preflightFailureCode || rpcErr.Data == nil {
+		return nil, false
+	}
+	data, err := json.Marshal(rpcErr.Data)
+	if err != nil {
+		return nil, false
+	}
+	var result SimulateTransactionResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, false
+	}
+	return &result, true
+}
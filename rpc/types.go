@@ -20,7 +20,9 @@ package rpc
 import (
 	"encoding/base64"
 	stdjson "encoding/json"
+	"errors"
 	"fmt"
+	"math/big"
 
 	bin "github.com/gagliardetto/binary"
 	"github.com/gagliardetto/solana-go"
@@ -148,10 +150,25 @@ type UiTokenAmount struct {
 	UiAmountString string `json:"uiAmountString"`
 }
 
+// RawAmount returns the exact raw amount of tokens (ignoring decimals),
+// parsed from the Amount string; the float UiAmount is never used,
+// as it loses precision for large amounts.
+// Returns nil if the amount is not a valid integer.
+func (a *UiTokenAmount) RawAmount() *big.Int {
+	if a == nil {
+		return nil
+	}
+	amount, ok := new(big.Int).SetString(a.Amount, 10)
+	if !ok {
+		return nil
+	}
+	return amount
+}
+
 type TransactionMeta struct {
 	// Error if transaction failed, null if transaction succeeded.
 	// https://github.com/solana-labs/solana/blob/master/sdk/src/transaction.rs#L24
-	Err interface{} `json:"err"`
+	Err *TransactionError `json:"err"`
 
 	// Fee this transaction was charged
 	Fee uint64 `json:"fee"`
@@ -182,6 +199,18 @@ type TransactionMeta struct {
 	Status DeprecatedTransactionMetaStatus `json:"status"`
 
 	Rewards []BlockReward `json:"rewards"`
+
+	// Addresses loaded from address lookup tables by a versioned (v0) transaction;
+	// omitted for legacy transactions.
+	LoadedAddresses *LoadedAddresses `json:"loadedAddresses,omitempty"`
+}
+
+type LoadedAddresses struct {
+	// Ordered list of base-58 encoded addresses for writable loaded accounts.
+	Writable solana.PublicKeySlice `json:"writable"`
+
+	// Ordered list of base-58 encoded addresses for readonly loaded accounts.
+	ReadOnly solana.PublicKeySlice `json:"readonly"`
 }
 
 type InnerInstruction struct {
@@ -199,7 +228,7 @@ type DeprecatedTransactionMetaStatus M
 
 type TransactionSignature struct {
 	// Error if transaction failed, nil if transaction succeeded.
-	Err interface{} `json:"err"`
+	Err *TransactionError `json:"err"`
 
 	// Memo associated with the transaction, nil if no memo is present.
 	Memo *string `json:"memo"`
@@ -366,6 +395,40 @@ type RPCFilterMemcmp struct {
 	Bytes  solana.Base58 `json:"bytes"`
 }
 
+// MaxRPCFilters is the maximum number of filters accepted by the RPC server
+// in a single request.
+const MaxRPCFilters = 4
+
+// Validate returns an error if the filter is malformed:
+// exactly one of DataSize and Memcmp must be set,
+// and Memcmp must have bytes to compare.
+func (f RPCFilter) Validate() error {
+	if f.Memcmp == nil && f.DataSize == 0 {
+		return errors.New("filter must set either DataSize or Memcmp")
+	}
+	if f.Memcmp != nil && f.DataSize != 0 {
+		return errors.New("filter must set only one of DataSize and Memcmp")
+	}
+	if f.Memcmp != nil && len(f.Memcmp.Bytes) == 0 {
+		return errors.New("memcmp filter has no bytes")
+	}
+	return nil
+}
+
+// ValidateRPCFilters validates each of the provided filters,
+// and their number against MaxRPCFilters.
+func ValidateRPCFilters(filters []RPCFilter) error {
+	if len(filters) > MaxRPCFilters {
+		return fmt.Errorf("too many filters: %d (max %d)", len(filters), MaxRPCFilters)
+	}
+	for i, filter := range filters {
+		if err := filter.Validate(); err != nil {
+			return fmt.Errorf("invalid filter [%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
 type CommitmentType string
 
 const (
@@ -404,7 +467,7 @@ type ParsedTransaction struct {
 type ParsedTransactionMeta struct {
 	// Error if transaction failed, null if transaction succeeded.
 	// https://github.com/solana-labs/solana/blob/master/sdk/src/transaction.rs#L24
-	Err interface{} `json:"err"`
+	Err *TransactionError `json:"err"`
 
 	// Fee this transaction was charged
 	Fee uint64 `json:"fee"`
@@ -442,6 +505,51 @@ type Message struct {
 	RecentBlockhash solana.Hash           `json:"recentBlockhash"`
 	Instructions    []CompiledInstruction `json:"instructions"`
 	Header          solana.MessageHeader  `json:"header"`
+
+	// List of address table lookups used by a versioned (v0) transaction
+	// to dynamically load addresses from on-chain address lookup tables;
+	// omitted for legacy transactions.
+	AddressTableLookups []MessageAddressTableLookup `json:"addressTableLookups,omitempty"`
+}
+
+type MessageAddressTableLookup struct {
+	// The account key of the address lookup table.
+	AccountKey solana.PublicKey `json:"accountKey"`
+
+	// List of indices used to load addresses of writable accounts from the lookup table.
+	// NOTE: it is actually a []uint8, but using a uint16 because []uint8 is treated as a []byte everywhere,
+	// and that can be an issue.
+	WritableIndexes []uint16 `json:"writableIndexes"`
+
+	// List of indices used to load addresses of readonly accounts from the lookup table.
+	// NOTE: it is actually a []uint8, but using a uint16 because []uint8 is treated as a []byte everywhere,
+	// and that can be an issue.
+	ReadonlyIndexes []uint16 `json:"readonlyIndexes"`
+}
+
+// GetAddressTableLookups returns the address table lookups of the message
+// in the form used by solana.Message.
+func (mx Message) GetAddressTableLookups() solana.MessageAddressTableLookupSlice {
+	if len(mx.AddressTableLookups) == 0 {
+		return nil
+	}
+	out := make(solana.MessageAddressTableLookupSlice, len(mx.AddressTableLookups))
+	for i, lookup := range mx.AddressTableLookups {
+		out[i] = solana.MessageAddressTableLookup{
+			AccountKey:      lookup.AccountKey,
+			WritableIndexes: uint16sToUint8s(lookup.WritableIndexes),
+			ReadonlyIndexes: uint16sToUint8s(lookup.ReadonlyIndexes),
+		}
+	}
+	return out
+}
+
+func uint16sToUint8s(in []uint16) []uint8 {
+	out := make([]uint8, len(in))
+	for i, v := range in {
+		out[i] = uint8(v)
+	}
+	return out
 }
 
 type ParsedMessageAccount struct {
@@ -464,6 +572,10 @@ type ParsedMessage struct {
 	RecentBlockHash string                 `json:"recentBlockhash"`
 }
 
+// ParsedInstruction is an instruction as returned by the RPC node
+// when the transaction is requested with the `jsonParsed` encoding.
+// Instructions of programs known to the node (e.g. "spl-token", "system")
+// come pre-parsed in `Parsed`; other instructions only carry `Data` and `Accounts`.
 type ParsedInstruction struct {
 	Program   string                   `json:"program,omitempty"`
 	ProgramId solana.PublicKey         `json:"programId,omitempty"`
@@ -472,9 +584,17 @@ type ParsedInstruction struct {
 	Accounts  []solana.PublicKey       `json:"accounts,omitempty"`
 }
 
+func (p *ParsedInstruction) IsParsed() bool {
+	return p.Parsed != nil
+}
+
+// InstructionInfoEnvelope contains the `parsed` field of a jsonParsed instruction,
+// which is either a JSON object (see InstructionInfo) or a plain string
+// (e.g. for the memo program).
 type InstructionInfoEnvelope struct {
 	asString          string
 	asInstructionInfo *InstructionInfo
+	raw               stdjson.RawMessage
 }
 
 type InstructionInfo struct {
@@ -492,6 +612,10 @@ type TransactionOpts struct {
 	PreflightCommitment CommitmentType      `json:"preflightCommitment,omitempty"`
 	MaxRetries          *uint               `json:"maxRetries"`
 	MinContextSlot      *uint64             `json:"minContextSlot"`
+
+	// If true, SendTransactionWithOpts doesn't verify the signatures
+	// of the transaction before submitting it.
+	SkipSignatureVerification bool `json:"-"`
 }
 
 func (opts *TransactionOpts) ToMap() M {
@@ -113,3 +113,69 @@ func TestData_DataBytesOrJSONFromBytes(t *testing.T) {
 	out := dataBytesOrJSON.GetBinary()
 	assert.Equal(t, in, out)
 }
+
+func TestClassifyAccount(t *testing.T) {
+	cases := []struct {
+		name    string
+		account *Account
+		kind    AccountKind
+	}{
+		{
+			"wallet",
+			&Account{Owner: solana.SystemProgramID, Data: DataBytesOrJSONFromBytes([]byte{})},
+			AccountKindSystemOwned,
+		},
+		{
+			"nonce",
+			&Account{Owner: solana.SystemProgramID, Data: DataBytesOrJSONFromBytes(make([]byte, 80))},
+			AccountKindNonceAccount,
+		},
+		{
+			"mint",
+			&Account{Owner: solana.TokenProgramID, Data: DataBytesOrJSONFromBytes(make([]byte, 82))},
+			AccountKindTokenMint,
+		},
+		{
+			"token account",
+			&Account{Owner: solana.TokenProgramID, Data: DataBytesOrJSONFromBytes(make([]byte, 165))},
+			AccountKindTokenAccount,
+		},
+		{
+			"multisig",
+			&Account{Owner: solana.TokenProgramID, Data: DataBytesOrJSONFromBytes(make([]byte, 355))},
+			AccountKindTokenMultisig,
+		},
+		{
+			"token-2022 mint with extensions",
+			&Account{Owner: solana.Token2022ProgramID, Data: DataBytesOrJSONFromBytes(append(make([]byte, 165), 1, 0, 0))},
+			AccountKindTokenMint,
+		},
+		{
+			"token-2022 account with extensions",
+			&Account{Owner: solana.Token2022ProgramID, Data: DataBytesOrJSONFromBytes(append(make([]byte, 165), 2, 0, 0))},
+			AccountKindTokenAccount,
+		},
+		{
+			"upgradeable program",
+			&Account{Owner: solana.BPFLoaderUpgradeableProgramID, Data: DataBytesOrJSONFromBytes(append([]byte{2, 0, 0, 0}, make([]byte, 32)...)), Executable: true},
+			AccountKindProgramAccount,
+		},
+		{
+			"stake",
+			&Account{Owner: solana.StakeProgramID, Data: DataBytesOrJSONFromBytes(make([]byte, 200))},
+			AccountKindStakeAccount,
+		},
+		{
+			"unknown",
+			&Account{Owner: solana.MemoProgramID, Data: DataBytesOrJSONFromBytes([]byte{1})},
+			AccountKindUnknown,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.kind, ClassifyAccount(c.account))
+		})
+	}
+	assert.Equal(t, AccountKindUnknown, ClassifyAccount(nil))
+	assert.Equal(t, "TokenMint", AccountKindTokenMint.String())
+}
@@ -15,6 +15,9 @@
 package ws
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
 )
@@ -48,16 +51,62 @@ func (cl *Client) AccountSubscribeWithOpts(
 	commitment rpc.CommitmentType,
 	encoding solana.EncodingType,
 ) (*AccountSubscription, error) {
+	return cl.AccountSubscribeWithConfig(
+		account,
+		&AccountSubscribeOpts{
+			Commitment: commitment,
+			Encoding:   encoding,
+		},
+	)
+}
+
+type AccountSubscribeOpts struct {
+	Commitment rpc.CommitmentType
+
+	// Encoding of the account data; defaults to base64.
+	// One of base58, base64, base64+zstd or jsonParsed.
+	Encoding solana.EncodingType
+
+	// Limit the returned account data to a range of bytes;
+	// only available for the base58, base64 and base64+zstd encodings.
+	DataSlice *rpc.DataSlice
+}
+
+// AccountSubscribeWithConfig subscribes to an account to receive notifications
+// when the lamports or data for a given account public key changes,
+// with the provided encoding and data slice.
+func (cl *Client) AccountSubscribeWithConfig(
+	account solana.PublicKey,
+	opts *AccountSubscribeOpts,
+) (*AccountSubscription, error) {
 
 	params := []interface{}{account.String()}
 	conf := map[string]interface{}{
 		"encoding": "base64",
 	}
-	if commitment != "" {
-		conf["commitment"] = commitment
-	}
-	if encoding != "" {
-		conf["encoding"] = encoding
+	if opts != nil {
+		if opts.Commitment != "" {
+			conf["commitment"] = opts.Commitment
+		}
+		if opts.Encoding != "" {
+			if !solana.IsAnyOfEncodingType(
+				opts.Encoding,
+				// Valid encodings:
+				solana.EncodingBase58,
+				solana.EncodingBase64,
+				solana.EncodingBase64Zstd,
+				solana.EncodingJSONParsed,
+			) {
+				return nil, fmt.Errorf("provided encoding is not supported: %s", opts.Encoding)
+			}
+			conf["encoding"] = opts.Encoding
+		}
+		if opts.DataSlice != nil {
+			if opts.Encoding == solana.EncodingJSONParsed {
+				return nil, errors.New("dataSlice is not supported with the jsonParsed encoding")
+			}
+			conf["dataSlice"] = opts.DataSlice
+		}
 	}
 
 	genSub, err := cl.subscribe(
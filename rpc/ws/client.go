@@ -19,6 +19,7 @@ package ws
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -43,6 +44,11 @@ type Client struct {
 	reconnectOnErr          bool
 }
 
+// ErrConnectionClosed is returned by the Recv method of every active
+// subscription when the websocket connection is closed;
+// callers must connect again and re-subscribe.
+var ErrConnectionClosed = errors.New("ws: connection closed")
+
 const (
 	// Time allowed to write a message to the peer.
 	writeWait = 10 * time.Second
@@ -118,7 +124,7 @@ func (c *Client) receiveMessages() {
 	for {
 		_, message, err := c.conn.ReadMessage()
 		if err != nil {
-			c.closeAllSubscription(err)
+			c.closeAllSubscription(fmt.Errorf("%w: %v", ErrConnectionClosed, err))
 			return
 		}
 		c.handleMessage(message)
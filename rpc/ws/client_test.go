@@ -20,6 +20,7 @@ package ws
 import (
 	"context"
 	"encoding/base64"
+	stdjson "encoding/json"
 	"fmt"
 	"net/http"
 	"os"
@@ -27,6 +28,7 @@ import (
 	"time"
 
 	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
 	"github.com/gagliardetto/solana-go/text"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -56,6 +58,140 @@ func Test_AccountSubscribe(t *testing.T) {
 	return
 }
 
+func Test_AccountSubscribe_Mock(t *testing.T) {
+	server := newMockWSServer(t)
+
+	c, err := Connect(context.Background(), server.URL())
+	require.NoError(t, err)
+	defer c.Close()
+
+	accountID := solana.MustPublicKeyFromBase58("SqJP6vrvMad5XBQK5PCFEZjeuQSFi959sdpqtSNvnsX")
+	sub, err := c.AccountSubscribe(accountID, rpc.CommitmentConfirmed)
+	require.NoError(t, err)
+
+	req := server.nextRequest(t)
+	require.Equal(t, "accountSubscribe", req["method"])
+	require.Equal(t,
+		[]interface{}{
+			accountID.String(),
+			map[string]interface{}{
+				"encoding":   "base64",
+				"commitment": "confirmed",
+			},
+		},
+		req["params"],
+	)
+
+	server.push(t, wrapIntoNotification("accountNotification",
+		`{"context":{"slot":5199307},"value":{"data":["AQID","base64"],"executable":false,"lamports":33594,"owner":"11111111111111111111111111111111","rentEpoch":635}}`,
+	))
+	got, err := sub.Recv()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5199307), got.Context.Slot)
+	require.Equal(t, uint64(33594), got.Value.Lamports)
+	require.Equal(t, solana.SystemProgramID, got.Value.Owner)
+	require.Equal(t, []byte{1, 2, 3}, got.Value.Data.GetBinary())
+
+	// Notifications for other subscriptions are not delivered.
+	server.push(t, wrapIntoNotificationForSubscription("accountNotification",
+		`{"context":{"slot":1},"value":{"data":["","base64"],"executable":false,"lamports":1,"owner":"11111111111111111111111111111111","rentEpoch":0}}`,
+		mockWSSubscriptionID+1,
+	))
+
+	server.closeConn(t)
+	_, err = sub.Recv()
+	require.ErrorIs(t, err, ErrConnectionClosed)
+	// The underlying read error is reported too.
+	require.Contains(t, err.Error(), "close 1006")
+}
+
+func Test_AccountSubscribeWithConfig_DataSlice(t *testing.T) {
+	server := newMockWSServer(t)
+
+	c, err := Connect(context.Background(), server.URL())
+	require.NoError(t, err)
+	defer c.Close()
+
+	accountID := solana.MustPublicKeyFromBase58("SqJP6vrvMad5XBQK5PCFEZjeuQSFi959sdpqtSNvnsX")
+	offset, length := uint64(8), uint64(11)
+	sub, err := c.AccountSubscribeWithConfig(accountID, &AccountSubscribeOpts{
+		Commitment: rpc.CommitmentProcessed,
+		Encoding:   solana.EncodingBase64Zstd,
+		DataSlice:  &rpc.DataSlice{Offset: &offset, Length: &length},
+	})
+	require.NoError(t, err)
+
+	req := server.nextRequest(t)
+	require.Equal(t, "accountSubscribe", req["method"])
+	require.Equal(t,
+		[]interface{}{
+			accountID.String(),
+			map[string]interface{}{
+				"encoding":   "base64+zstd",
+				"commitment": "processed",
+				"dataSlice": map[string]interface{}{
+					"offset": stdjson.Number("8"),
+					"length": stdjson.Number("11"),
+				},
+			},
+		},
+		req["params"],
+	)
+
+	// The notification data is decoded according to the requested encoding.
+	server.push(t, wrapIntoNotification("accountNotification",
+		`{"context":{"slot":5199307},"value":{"data":["KLUv/QQAWQAAaGVsbG8td29ybGTcLcaB","base64+zstd"],"executable":false,"lamports":33594,"owner":"11111111111111111111111111111111","rentEpoch":635}}`,
+	))
+	got, err := sub.Recv()
+	require.NoError(t, err)
+	require.Equal(t, []byte("hello-world"), got.Value.Data.GetBinary())
+}
+
+func Test_AccountSubscribeWithConfig_Validation(t *testing.T) {
+	server := newMockWSServer(t)
+
+	c, err := Connect(context.Background(), server.URL())
+	require.NoError(t, err)
+	defer c.Close()
+
+	accountID := solana.MustPublicKeyFromBase58("SqJP6vrvMad5XBQK5PCFEZjeuQSFi959sdpqtSNvnsX")
+	_, err = c.AccountSubscribeWithConfig(accountID, &AccountSubscribeOpts{
+		Encoding: solana.EncodingJSON,
+	})
+	require.EqualError(t, err, "provided encoding is not supported: json")
+
+	length := uint64(32)
+	_, err = c.AccountSubscribeWithConfig(accountID, &AccountSubscribeOpts{
+		Encoding:  solana.EncodingJSONParsed,
+		DataSlice: &rpc.DataSlice{Length: &length},
+	})
+	require.EqualError(t, err, "dataSlice is not supported with the jsonParsed encoding")
+}
+
+func Test_AccountSubscribe_Unsubscribe(t *testing.T) {
+	server := newMockWSServer(t)
+
+	c, err := Connect(context.Background(), server.URL())
+	require.NoError(t, err)
+	defer c.Close()
+
+	sub, err := c.AccountSubscribe(solana.MustPublicKeyFromBase58("SqJP6vrvMad5XBQK5PCFEZjeuQSFi959sdpqtSNvnsX"), "")
+	require.NoError(t, err)
+	require.Equal(t, "accountSubscribe", server.nextRequest(t)["method"])
+
+	// Wait for the subscription to be confirmed.
+	require.Eventually(t, func() bool {
+		c.lock.RLock()
+		defer c.lock.RUnlock()
+		return len(c.subscriptionByWSSubID) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	sub.Unsubscribe()
+	req := server.nextRequest(t)
+	require.Equal(t, "accountUnsubscribe", req["method"])
+	require.Equal(t, []interface{}{stdjson.Number("42")}, req["params"])
+}
+
 func Test_AccountSubscribeWithHttpHeader(t *testing.T) {
 	t.Skip("Never ending test, revisit me to not depend on actual network calls, or hide between env flag")
 	zlog, _ = zap.NewDevelopment()
@@ -111,6 +247,52 @@ func Test_AccountSubscribeWithHttpHeader(t *testing.T) {
 	t.Log("data: ", data.Value.Account.Data)
 }
 
+func Test_LogsSubscribeMentions_Mock(t *testing.T) {
+	server := newMockWSServer(t)
+
+	c, err := Connect(context.Background(), server.URL())
+	require.NoError(t, err)
+	defer c.Close()
+
+	program := solana.MustPublicKeyFromBase58("83astBRguLMdt2h5U1Tpdq5tjFoJ6noeGwaY3mDLVcri")
+	sub, err := c.LogsSubscribeMentions(program, rpc.CommitmentFinalized)
+	require.NoError(t, err)
+
+	req := server.nextRequest(t)
+	require.Equal(t, "logsSubscribe", req["method"])
+	require.Equal(t,
+		[]interface{}{
+			map[string]interface{}{
+				"mentions": []interface{}{program.String()},
+			},
+			map[string]interface{}{
+				"commitment": "finalized",
+			},
+		},
+		req["params"],
+	)
+
+	server.push(t, wrapIntoNotification("logsNotification",
+		`{"context":{"slot":5208469},"value":{"signature":"5h6xBEauJ3PK6SWCZ1PGjBvj8vDdWG3KpwATGy1ARAXFSDwt8GFXM7W5Ncn16wmqokgpiKRLuS83KUxyZyv2sUYv","err":null,"logs":["BPF program 83astBRguLMdt2h5U1Tpdq5tjFoJ6noeGwaY3mDLVcri success"]}}`,
+	))
+	got, err := sub.Recv()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5208469), got.Context.Slot)
+	require.Equal(t, solana.MustSignatureFromBase58("5h6xBEauJ3PK6SWCZ1PGjBvj8vDdWG3KpwATGy1ARAXFSDwt8GFXM7W5Ncn16wmqokgpiKRLuS83KUxyZyv2sUYv"), got.Value.Signature)
+	require.Nil(t, got.Value.Err)
+	require.Equal(t, []string{"BPF program 83astBRguLMdt2h5U1Tpdq5tjFoJ6noeGwaY3mDLVcri success"}, got.Value.Logs)
+}
+
+func Test_LogsSubscribe_Validation(t *testing.T) {
+	c := &Client{}
+
+	_, err := c.LogsSubscribe("mentions", "")
+	require.EqualError(t, err, `logs subscribe: invalid filter "mentions"`)
+
+	_, err = c.LogsSubscribeMentions(solana.PublicKey{}, "")
+	require.EqualError(t, err, "logs subscribe: mentions pubkey is not set")
+}
+
 func Test_ProgramSubscribe(t *testing.T) {
 	t.Skip("Never ending test, revisit me to not depend on actual network calls, or hide between env flag")
 
@@ -156,3 +338,83 @@ func Test_SlotSubscribe(t *testing.T) {
 	fmt.Println("data received: ", data.Parent)
 	return
 }
+
+func Test_SlotsUpdatesSubscribe(t *testing.T) {
+	server := newMockWSServer(t,
+		wrapIntoNotification("slotsUpdatesNotification", `{"slot":120,"timestamp":1625081266243,"type":"firstShredReceived"}`),
+		wrapIntoNotification("slotsUpdatesNotification", `{"parent":119,"slot":120,"timestamp":1625081266321,"type":"frozen","stats":{"maxTransactionsPerEntry":108,"numFailedTransactions":2,"numSuccessfulTransactions":502,"numTransactionEntries":54}}`),
+	)
+
+	c, err := Connect(context.Background(), server.URL())
+	require.NoError(t, err)
+	defer c.Close()
+
+	sub, err := c.SlotsUpdatesSubscribe()
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	req := server.nextRequest(t)
+	require.Equal(t, "slotsUpdatesSubscribe", req["method"])
+
+	got, err := sub.Recv()
+	require.NoError(t, err)
+	firstShredTimestamp := solana.UnixTimeMilliseconds(1625081266243)
+	require.Equal(t,
+		&SlotsUpdatesResult{
+			Slot:      120,
+			Timestamp: &firstShredTimestamp,
+			Type:      SlotsUpdatesFirstShredReceived,
+		},
+		got,
+	)
+
+	got, err = sub.Recv()
+	require.NoError(t, err)
+	frozenTimestamp := solana.UnixTimeMilliseconds(1625081266321)
+	require.Equal(t,
+		&SlotsUpdatesResult{
+			Parent:    119,
+			Slot:      120,
+			Timestamp: &frozenTimestamp,
+			Type:      SlotsUpdatesFrozen,
+			Stats: &BankStats{
+				NumTransactionEntries:     54,
+				NumSuccessfulTransactions: 502,
+				NumFailedTransactions:     2,
+				MaxTransactionsPerEntry:   108,
+			},
+		},
+		got,
+	)
+}
+
+func Test_VoteSubscribe(t *testing.T) {
+	server := newMockWSServer(t,
+		wrapIntoNotification("voteNotification", `{"votePubkey":"7xLk17EQQ5KLDLDe44wCmupJKJjTGd8hs3eSVVhCx932","slots":[1,2],"hash":"8Rshv2oMkPu5E4opXTRyuyBeZBqQ4S477VG26wUTFxUM","timestamp":1627328694,"signature":"5yUSwqQqeZLEEYKxnG4JC4XhaaBpV3RS4nQbK8bQTyjLX5btVq9A1Ja5nuJzV7Z3Zq8G6EVKFvN4DKUL6PSAxmTk"}`),
+	)
+
+	c, err := Connect(context.Background(), server.URL())
+	require.NoError(t, err)
+	defer c.Close()
+
+	sub, err := c.VoteSubscribe()
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	req := server.nextRequest(t)
+	require.Equal(t, "voteSubscribe", req["method"])
+
+	got, err := sub.Recv()
+	require.NoError(t, err)
+	timestamp := solana.UnixTimeSeconds(1627328694)
+	require.Equal(t,
+		&VoteResult{
+			VotePubkey: solana.MustPublicKeyFromBase58("7xLk17EQQ5KLDLDe44wCmupJKJjTGd8hs3eSVVhCx932"),
+			Hash:       solana.MustHashFromBase58("8Rshv2oMkPu5E4opXTRyuyBeZBqQ4S477VG26wUTFxUM"),
+			Slots:      []uint64{1, 2},
+			Timestamp:  &timestamp,
+			Signature:  solana.MustSignatureFromBase58("5yUSwqQqeZLEEYKxnG4JC4XhaaBpV3RS4nQbK8bQTyjLX5btVq9A1Ja5nuJzV7Z3Zq8G6EVKFvN4DKUL6PSAxmTk"),
+		},
+		got,
+	)
+}
@@ -15,6 +15,8 @@
 package ws
 
 import (
+	"fmt"
+
 	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
 )
@@ -27,7 +29,7 @@ type LogResult struct {
 		// The transaction signature.
 		Signature solana.Signature `json:"signature"`
 		// Error if transaction failed, null if transaction succeeded.
-		Err interface{} `json:"err"`
+		Err *rpc.TransactionError `json:"err"`
 		// Array of log messages the transaction instructions output
 		// during execution, null if simulation failed before the transaction
 		// was able to execute (for example due to an invalid blockhash
@@ -51,19 +53,28 @@ func (cl *Client) LogsSubscribe(
 	filter LogsSubscribeFilterType,
 	commitment rpc.CommitmentType, // (optional)
 ) (*LogSubscription, error) {
+	switch filter {
+	case LogsSubscribeFilterAll, LogsSubscribeFilterAllWithVotes:
+	default:
+		return nil, fmt.Errorf("logs subscribe: invalid filter %q", filter)
+	}
 	return cl.logsSubscribe(
 		filter,
 		commitment,
 	)
 }
 
-// LogsSubscribe subscribes to all transactions that mention the provided Pubkey.
+// LogsSubscribeMentions subscribes to all transactions that mention the provided Pubkey.
+// The RPC node accepts exactly one pubkey in the `mentions` filter.
 func (cl *Client) LogsSubscribeMentions(
 	// Subscribe to all transactions that mention the provided Pubkey.
 	mentions solana.PublicKey,
 	// (optional)
 	commitment rpc.CommitmentType,
 ) (*LogSubscription, error) {
+	if mentions.IsZero() {
+		return nil, fmt.Errorf("logs subscribe: mentions pubkey is not set")
+	}
 	return cl.logsSubscribe(
 		rpc.M{
 			"mentions": []string{mentions.String()},
@@ -0,0 +1,138 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ws
+
+import (
+	"bytes"
+	stdjson "encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+// mockWSSubscriptionID is the subscription id assigned by the mock server
+// to the first subscription; notifications must reference it.
+// Subsequent subscriptions are assigned consecutive ids.
+const mockWSSubscriptionID = 42
+
+type mockWSServer struct {
+	*httptest.Server
+	requests chan map[string]interface{}
+
+	lock sync.Mutex
+	conn *websocket.Conn
+}
+
+// newMockWSServer starts a websocket server that confirms each subscription
+// request it receives, and then pushes the provided notifications.
+func newMockWSServer(t *testing.T, notifications ...string) *mockWSServer {
+	mock := &mockWSServer{
+		requests: make(chan map[string]interface{}, 100),
+	}
+	upgrader := websocket.Upgrader{}
+	mock.Server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		conn, err := upgrader.Upgrade(rw, req, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		mock.lock.Lock()
+		mock.conn = conn
+		mock.lock.Unlock()
+
+		nextSubscriptionID := mockWSSubscriptionID
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var request map[string]interface{}
+			decoder := stdjson.NewDecoder(bytes.NewReader(message))
+			decoder.UseNumber()
+			if err := decoder.Decode(&request); err != nil {
+				return
+			}
+			mock.requests <- request
+
+			if strings.HasSuffix(request["method"].(string), "Unsubscribe") {
+				continue
+			}
+			confirmation := fmt.Sprintf(`{"jsonrpc":"2.0","result":%d,"id":%s}`, nextSubscriptionID, request["id"])
+			nextSubscriptionID++
+			if err := mock.write(confirmation); err != nil {
+				return
+			}
+			for _, notification := range notifications {
+				if err := mock.write(notification); err != nil {
+					return
+				}
+			}
+		}
+	}))
+	t.Cleanup(mock.Close)
+	return mock
+}
+
+func (s *mockWSServer) write(message string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.conn.WriteMessage(websocket.TextMessage, []byte(message))
+}
+
+// push sends the provided message to the connected client.
+func (s *mockWSServer) push(t *testing.T, message string) {
+	require.NoError(t, s.write(message))
+}
+
+// closeConn closes the connection with the client.
+func (s *mockWSServer) closeConn(t *testing.T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	require.NoError(t, s.conn.Close())
+}
+
+func (s *mockWSServer) URL() string {
+	return "ws" + strings.TrimPrefix(s.Server.URL, "http")
+}
+
+// nextRequest returns the next request received by the server,
+// with numbers decoded as stdjson.Number.
+func (s *mockWSServer) nextRequest(t *testing.T) map[string]interface{} {
+	select {
+	case req := <-s.requests:
+		return req
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for a request")
+		return nil
+	}
+}
+
+// wrapIntoNotification wraps the provided result into a subscription notification.
+func wrapIntoNotification(method string, result string) string {
+	return wrapIntoNotificationForSubscription(method, result, mockWSSubscriptionID)
+}
+
+// wrapIntoNotificationForSubscription wraps the provided result into
+// a notification for the provided subscription id.
+func wrapIntoNotificationForSubscription(method string, result string, subscriptionID int) string {
+	return fmt.Sprintf(`{"jsonrpc":"2.0","method":%q,"params":{"result":%s,"subscription":%d}}`, method, result, subscriptionID)
+}
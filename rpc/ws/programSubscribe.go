@@ -60,6 +60,9 @@ func (cl *Client) ProgramSubscribeWithOpts(
 		conf["encoding"] = encoding
 	}
 	if filters != nil && len(filters) > 0 {
+		if err := rpc.ValidateRPCFilters(filters); err != nil {
+			return nil, err
+		}
 		conf["filters"] = filters
 	}
 
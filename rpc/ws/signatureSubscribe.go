@@ -24,7 +24,7 @@ type SignatureResult struct {
 		Slot uint64
 	} `json:"context"`
 	Value struct {
-		Err interface{} `json:"err"`
+		Err *rpc.TransactionError `json:"err"`
 	} `json:"value"`
 }
 
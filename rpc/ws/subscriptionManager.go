@@ -0,0 +1,246 @@
+// Copyright 2021 github.com/gagliardetto
+// This file has been modified by github.com/gagliardetto
+//
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+
+package ws
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// SubscriptionKind is the kind of a subscription hosted by a SubscriptionManager.
+type SubscriptionKind string
+
+const (
+	SubscriptionKindAccount SubscriptionKind = "account"
+	SubscriptionKindProgram SubscriptionKind = "program"
+	SubscriptionKindLogs    SubscriptionKind = "logs"
+)
+
+// Event is a notification (or error) received on one of the
+// subscriptions of a SubscriptionManager.
+type Event struct {
+	// ID is the id returned by the SubscriptionManager when subscribing.
+	ID uint64
+	// Kind is the kind of the subscription.
+	Kind SubscriptionKind
+	// Result is the decoded notification:
+	// *AccountResult, *ProgramResult or *LogResult, depending on Kind.
+	// It is nil if Err is set.
+	Result interface{}
+	// Err is set if the subscription was terminated because of an error;
+	// no further events will be received for this subscription.
+	Err error
+}
+
+// ErrSubscriptionManagerClosed is returned when subscribing
+// on a closed SubscriptionManager.
+var ErrSubscriptionManagerClosed = errors.New("subscription manager is closed")
+
+// SubscriptionManager multiplexes many account, program and logs subscriptions
+// over a single websocket connection, and delivers all their notifications
+// on a single channel, tagged by subscription id.
+type SubscriptionManager struct {
+	client *Client
+	events chan Event
+
+	lock   sync.Mutex
+	nextID uint64
+	subs   map[uint64]*managedSubscription
+	closed bool
+	wg     sync.WaitGroup
+}
+
+type managedSubscription struct {
+	kind SubscriptionKind
+	sub  *Subscription
+	done chan struct{}
+}
+
+// NewSubscriptionManager creates a new SubscriptionManager that hosts
+// its subscriptions on the provided client.
+func NewSubscriptionManager(client *Client) *SubscriptionManager {
+	return &SubscriptionManager{
+		client: client,
+		events: make(chan Event, 1024),
+		nextID: 1,
+		subs:   map[uint64]*managedSubscription{},
+	}
+}
+
+// Events returns the channel on which the events of all the subscriptions
+// are delivered. The channel is closed by Close.
+func (m *SubscriptionManager) Events() <-chan Event {
+	return m.events
+}
+
+// AccountSubscribe subscribes to an account, and returns the id of the subscription.
+func (m *SubscriptionManager) AccountSubscribe(
+	account solana.PublicKey,
+	commitment rpc.CommitmentType,
+) (uint64, error) {
+	sub, err := m.client.AccountSubscribe(account, commitment)
+	if err != nil {
+		return 0, err
+	}
+	return m.add(SubscriptionKindAccount, sub.sub)
+}
+
+// ProgramSubscribe subscribes to a program, and returns the id of the subscription.
+func (m *SubscriptionManager) ProgramSubscribe(
+	programID solana.PublicKey,
+	commitment rpc.CommitmentType,
+) (uint64, error) {
+	sub, err := m.client.ProgramSubscribe(programID, commitment)
+	if err != nil {
+		return 0, err
+	}
+	return m.add(SubscriptionKindProgram, sub.sub)
+}
+
+// LogsSubscribe subscribes to transaction logging, and returns the id of the subscription.
+func (m *SubscriptionManager) LogsSubscribe(
+	filter LogsSubscribeFilterType,
+	commitment rpc.CommitmentType,
+) (uint64, error) {
+	sub, err := m.client.LogsSubscribe(filter, commitment)
+	if err != nil {
+		return 0, err
+	}
+	return m.add(SubscriptionKindLogs, sub.sub)
+}
+
+// LogsSubscribeMentions subscribes to all transactions that mention the provided Pubkey,
+// and returns the id of the subscription.
+func (m *SubscriptionManager) LogsSubscribeMentions(
+	mentions solana.PublicKey,
+	commitment rpc.CommitmentType,
+) (uint64, error) {
+	sub, err := m.client.LogsSubscribeMentions(mentions, commitment)
+	if err != nil {
+		return 0, err
+	}
+	return m.add(SubscriptionKindLogs, sub.sub)
+}
+
+// Kind returns the kind of the subscription with the provided id.
+func (m *SubscriptionManager) Kind(id uint64) (SubscriptionKind, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	managed, ok := m.subs[id]
+	if !ok {
+		return "", false
+	}
+	return managed.kind, true
+}
+
+// Unsubscribe terminates the subscription with the provided id.
+func (m *SubscriptionManager) Unsubscribe(id uint64) error {
+	m.lock.Lock()
+	managed, ok := m.subs[id]
+	if ok {
+		delete(m.subs, id)
+	}
+	m.lock.Unlock()
+	if !ok {
+		return fmt.Errorf("subscription %d not found", id)
+	}
+	close(managed.done)
+	managed.sub.Unsubscribe()
+	return nil
+}
+
+// Close terminates all the subscriptions and closes the Events channel.
+// It does not close the underlying client.
+func (m *SubscriptionManager) Close() {
+	m.lock.Lock()
+	if m.closed {
+		m.lock.Unlock()
+		return
+	}
+	m.closed = true
+	subs := m.subs
+	m.subs = map[uint64]*managedSubscription{}
+	m.lock.Unlock()
+
+	for _, managed := range subs {
+		close(managed.done)
+		managed.sub.Unsubscribe()
+	}
+	m.wg.Wait()
+	close(m.events)
+}
+
+func (m *SubscriptionManager) add(kind SubscriptionKind, sub *Subscription) (uint64, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.closed {
+		sub.Unsubscribe()
+		return 0, ErrSubscriptionManagerClosed
+	}
+
+	id := m.nextID
+	m.nextID++
+	managed := &managedSubscription{
+		kind: kind,
+		sub:  sub,
+		done: make(chan struct{}),
+	}
+	m.subs[id] = managed
+
+	m.wg.Add(1)
+	go m.forward(id, managed)
+	return id, nil
+}
+
+// forward delivers the notifications of a subscription to the Events channel
+// until the subscription is terminated.
+func (m *SubscriptionManager) forward(id uint64, managed *managedSubscription) {
+	defer m.wg.Done()
+	for {
+		event := Event{ID: id, Kind: managed.kind}
+		select {
+		case <-managed.done:
+			return
+		case result := <-managed.sub.stream:
+			event.Result = result
+		case err := <-managed.sub.err:
+			if err == nil {
+				// Unsubscribed.
+				return
+			}
+			event.Err = err
+		}
+
+		select {
+		case <-managed.done:
+			return
+		case m.events <- event:
+		}
+
+		if event.Err != nil {
+			m.lock.Lock()
+			if m.subs[id] == managed {
+				delete(m.subs, id)
+			}
+			m.lock.Unlock()
+			return
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2021 github.com/gagliardetto
+// This file has been modified by github.com/gagliardetto
+//
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+
+package ws
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func nextEvent(t *testing.T, events <-chan Event) Event {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for an event")
+		return Event{}
+	}
+}
+
+func TestSubscriptionManager(t *testing.T) {
+	server := newMockWSServer(t)
+
+	c, err := Connect(context.Background(), server.URL())
+	require.NoError(t, err)
+	defer c.Close()
+
+	manager := NewSubscriptionManager(c)
+	defer manager.Close()
+
+	account := solana.MustPublicKeyFromBase58("SqJP6vrvMad5XBQK5PCFEZjeuQSFi959sdpqtSNvnsX")
+	program := solana.MustPublicKeyFromBase58("EUqojwWA2rd19FZrzeBncJsm38Jm1hEhE3zsmX3bRc2o")
+
+	accountSubID, err := manager.AccountSubscribe(account, "")
+	require.NoError(t, err)
+	require.Equal(t, "accountSubscribe", server.nextRequest(t)["method"])
+
+	programSubID, err := manager.ProgramSubscribe(program, "")
+	require.NoError(t, err)
+	require.Equal(t, "programSubscribe", server.nextRequest(t)["method"])
+
+	logsSubID, err := manager.LogsSubscribe(LogsSubscribeFilterAll, "")
+	require.NoError(t, err)
+	require.Equal(t, "logsSubscribe", server.nextRequest(t)["method"])
+
+	require.NotEqual(t, accountSubID, programSubID)
+	require.NotEqual(t, programSubID, logsSubID)
+
+	kind, ok := manager.Kind(programSubID)
+	require.True(t, ok)
+	require.Equal(t, SubscriptionKindProgram, kind)
+
+	// The mock server assigns consecutive ws subscription ids.
+	server.push(t, wrapIntoNotificationForSubscription("logsNotification",
+		`{"context":{"slot":5208469},"value":{"signature":"5h6xBEauJ3PK6SWCZ1PGjBvj8vDdWG3KpwATGy1ARAXFSDwt8GFXM7W5Ncn16wmqokgpiKRLuS83KUxyZyv2sUYv","err":null,"logs":["BPF program 83astBRguLMdt2h5U1Tpdq5tjFoJ6noeGwaY3mDLVcri success"]}}`,
+		mockWSSubscriptionID+2,
+	))
+	server.push(t, wrapIntoNotificationForSubscription("accountNotification",
+		`{"context":{"slot":5199307},"value":{"data":["","base64"],"executable":false,"lamports":33594,"owner":"11111111111111111111111111111111","rentEpoch":635}}`,
+		mockWSSubscriptionID,
+	))
+	server.push(t, wrapIntoNotificationForSubscription("programNotification",
+		`{"context":{"slot":5208469},"value":{"pubkey":"H4vnBqifaSACnKa7acsxstsY1iV1bvJNxsCY7enrd1hq","account":{"data":["","base64"],"executable":false,"lamports":33594,"owner":"EUqojwWA2rd19FZrzeBncJsm38Jm1hEhE3zsmX3bRc2o","rentEpoch":636}}}`,
+		mockWSSubscriptionID+1,
+	))
+
+	// Events of different subscriptions are not ordered.
+	events := map[uint64]Event{}
+	for i := 0; i < 3; i++ {
+		event := nextEvent(t, manager.Events())
+		require.NoError(t, event.Err)
+		events[event.ID] = event
+	}
+
+	require.Equal(t, SubscriptionKindLogs, events[logsSubID].Kind)
+	require.Equal(t,
+		"BPF program 83astBRguLMdt2h5U1Tpdq5tjFoJ6noeGwaY3mDLVcri success",
+		events[logsSubID].Result.(*LogResult).Value.Logs[0],
+	)
+
+	require.Equal(t, SubscriptionKindAccount, events[accountSubID].Kind)
+	require.Equal(t, uint64(33594), events[accountSubID].Result.(*AccountResult).Value.Lamports)
+
+	require.Equal(t, SubscriptionKindProgram, events[programSubID].Kind)
+	require.Equal(t,
+		solana.MustPublicKeyFromBase58("H4vnBqifaSACnKa7acsxstsY1iV1bvJNxsCY7enrd1hq"),
+		events[programSubID].Result.(*ProgramResult).Value.Pubkey,
+	)
+
+	// Unsubscribing sends the unsubscribe call for the right ws subscription,
+	// and forgets the subscription.
+	require.NoError(t, manager.Unsubscribe(accountSubID))
+	req := server.nextRequest(t)
+	require.Equal(t, "accountUnsubscribe", req["method"])
+	require.Equal(t, []interface{}{stdjson.Number("42")}, req["params"])
+
+	_, ok = manager.Kind(accountSubID)
+	require.False(t, ok)
+	require.Error(t, manager.Unsubscribe(accountSubID))
+
+	// The remaining subscriptions still deliver events.
+	server.push(t, wrapIntoNotificationForSubscription("logsNotification",
+		`{"context":{"slot":5208470},"value":{"signature":"5h6xBEauJ3PK6SWCZ1PGjBvj8vDdWG3KpwATGy1ARAXFSDwt8GFXM7W5Ncn16wmqokgpiKRLuS83KUxyZyv2sUYv","err":null,"logs":[]}}`,
+		mockWSSubscriptionID+2,
+	))
+	event := nextEvent(t, manager.Events())
+	require.Equal(t, logsSubID, event.ID)
+	require.Equal(t, uint64(5208470), event.Result.(*LogResult).Context.Slot)
+
+	manager.Close()
+	_, open := <-manager.Events()
+	require.False(t, open)
+
+	_, err = manager.AccountSubscribe(account, "")
+	require.Equal(t, ErrSubscriptionManagerClosed, err)
+}
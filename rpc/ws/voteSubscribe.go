@@ -19,12 +19,16 @@ import (
 )
 
 type VoteResult struct {
+	// The vote account address.
+	VotePubkey solana.PublicKey `json:"votePubkey"`
 	// The vote hash.
 	Hash solana.Hash `json:"hash"`
 	// The slots covered by the vote.
 	Slots []uint64 `json:"slots"`
 	// The timestamp of the vote.
 	Timestamp *solana.UnixTimeSeconds `json:"timestamp,omitempty"`
+	// The signature of the transaction that contained this vote.
+	Signature solana.Signature `json:"signature"`
 }
 
 // VoteSubscribe (UNSTABLE, disabled by default) subscribes
@@ -0,0 +1,140 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"errors"
+	"fmt"
+
+	bin "github.com/gagliardetto/binary"
+)
+
+// MaxTransactionSize is the maximum size of a serialized transaction, in bytes
+// (i.e. the size of a network packet: 1280 - 40 (IPv6 header) - 8 (fragment header)).
+const MaxTransactionSize = 1232
+
+type SplitInstructionsOption interface {
+	apply(opts *splitInstructionsOptions)
+}
+
+type splitInstructionsOptions struct {
+	payer                PublicKey
+	reserveComputeBudget bool
+}
+
+type splitInstructionsOptionFunc func(opts *splitInstructionsOptions)
+
+func (f splitInstructionsOptionFunc) apply(opts *splitInstructionsOptions) {
+	f(opts)
+}
+
+// SplitWithFeePayer sets the fee payer of the resulting transactions.
+// If not set, defaults to the first signer account of the first instruction.
+func SplitWithFeePayer(payer PublicKey) SplitInstructionsOption {
+	return splitInstructionsOptionFunc(func(opts *splitInstructionsOptions) { opts.payer = payer })
+}
+
+// SplitWithComputeBudgetReservation makes the splitter reserve room in each
+// resulting transaction for a SetComputeUnitLimit and a SetComputeUnitPrice
+// instruction, so that they can be added later (e.g. to set a priority fee)
+// without pushing the transaction over MaxTransactionSize.
+func SplitWithComputeBudgetReservation() SplitInstructionsOption {
+	return splitInstructionsOptionFunc(func(opts *splitInstructionsOptions) { opts.reserveComputeBudget = true })
+}
+
+// SplitInstructions packs the provided instructions, in order, into as few groups as possible,
+// such that a transaction built from each group fits into MaxTransactionSize.
+// It returns an error if a single instruction doesn't fit into a transaction.
+func SplitInstructions(instructions []Instruction, opts ...SplitInstructionsOption) ([][]Instruction, error) {
+	if len(instructions) == 0 {
+		return nil, errors.New("no instructions to split")
+	}
+	options := splitInstructionsOptions{}
+	for _, opt := range opts {
+		opt.apply(&options)
+	}
+
+	payer := options.payer
+	if payer.IsZero() {
+		for _, acc := range instructions[0].Accounts() {
+			if acc.IsSigner {
+				payer = acc.PublicKey
+				break
+			}
+		}
+		if payer.IsZero() {
+			return nil, errors.New("cannot determine fee payer; use SplitWithFeePayer")
+		}
+	}
+
+	var reserved []Instruction
+	if options.reserveComputeBudget {
+		reserved = computeBudgetPlaceholders()
+	}
+
+	out := make([][]Instruction, 0)
+	current := make([]Instruction, 0)
+	for index, inst := range instructions {
+		candidate := append(append([]Instruction{}, current...), inst)
+		size, err := estimateTransactionSize(append(append([]Instruction{}, reserved...), candidate...), payer)
+		if err != nil {
+			return nil, fmt.Errorf("instruction [%d]: %w", index, err)
+		}
+		if size <= MaxTransactionSize {
+			current = candidate
+			continue
+		}
+		if len(current) == 0 {
+			return nil, fmt.Errorf("instruction [%d] doesn't fit into a transaction: %d bytes (max %d)", index, size, MaxTransactionSize)
+		}
+		out = append(out, current)
+		current = []Instruction{inst}
+		size, err = estimateTransactionSize(append(append([]Instruction{}, reserved...), current...), payer)
+		if err != nil {
+			return nil, fmt.Errorf("instruction [%d]: %w", index, err)
+		}
+		if size > MaxTransactionSize {
+			return nil, fmt.Errorf("instruction [%d] doesn't fit into a transaction: %d bytes (max %d)", index, size, MaxTransactionSize)
+		}
+	}
+	out = append(out, current)
+	return out, nil
+}
+
+// computeBudgetPlaceholders returns a SetComputeUnitLimit and a SetComputeUnitPrice instruction
+// with the same serialized size as the real ones.
+func computeBudgetPlaceholders() []Instruction {
+	return []Instruction{
+		NewInstruction(ComputeBudget, AccountMetaSlice{}, make([]byte, 1+4)),
+		NewInstruction(ComputeBudget, AccountMetaSlice{}, make([]byte, 1+8)),
+	}
+}
+
+// estimateTransactionSize returns the size of the serialized transaction
+// made of the provided instructions, once signed.
+func estimateTransactionSize(instructions []Instruction, payer PublicKey) (int, error) {
+	tx, err := NewTransaction(instructions, Hash{}, TransactionPayer(payer))
+	if err != nil {
+		return 0, err
+	}
+	messageContent, err := tx.Message.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+	numSignatures := int(tx.Message.Header.NumRequiredSignatures)
+	signatureCount := []byte{}
+	bin.EncodeCompactU16Length(&signatureCount, numSignatures)
+	return len(signatureCount) + numSignatures*SignatureLength + len(messageContent), nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitInstructions(t *testing.T) {
+	payer := NewWallet().PublicKey()
+
+	instructions := make([]Instruction, 0)
+	for i := 0; i < 60; i++ {
+		data := make([]byte, 12)
+		binary.LittleEndian.PutUint32(data, 2)
+		binary.LittleEndian.PutUint64(data[4:], uint64(i))
+		instructions = append(instructions, NewInstruction(
+			SystemProgramID,
+			AccountMetaSlice{
+				Meta(payer).WRITE().SIGNER(),
+				Meta(NewWallet().PublicKey()).WRITE(),
+			},
+			data,
+		))
+	}
+
+	computeBudget := func() []Instruction {
+		limit := make([]byte, 5)
+		limit[0] = computeBudgetSetComputeUnitLimit
+		binary.LittleEndian.PutUint32(limit[1:], 1_400_000)
+		price := make([]byte, 9)
+		price[0] = computeBudgetSetComputeUnitPrice
+		binary.LittleEndian.PutUint64(price[1:], 50_000)
+		return []Instruction{
+			NewInstruction(ComputeBudget, AccountMetaSlice{}, limit),
+			NewInstruction(ComputeBudget, AccountMetaSlice{}, price),
+		}
+	}
+	flatten := func(groups [][]Instruction) []Instruction {
+		out := make([]Instruction, 0)
+		for _, group := range groups {
+			out = append(out, group...)
+		}
+		return out
+	}
+
+	{
+		groups, err := SplitInstructions(instructions)
+		require.NoError(t, err)
+		require.True(t, len(groups) > 1)
+		require.Equal(t, instructions, flatten(groups))
+
+		overflows := false
+		for _, group := range groups {
+			size, err := estimateTransactionSize(group, payer)
+			require.NoError(t, err)
+			require.LessOrEqual(t, size, MaxTransactionSize)
+
+			size, err = estimateTransactionSize(append(computeBudget(), group...), payer)
+			require.NoError(t, err)
+			if size > MaxTransactionSize {
+				overflows = true
+			}
+		}
+		// Without the reservation, adding the compute budget instructions
+		// pushes (at least) one packed transaction over the limit.
+		require.True(t, overflows)
+	}
+	{
+		groups, err := SplitInstructions(instructions, SplitWithFeePayer(payer), SplitWithComputeBudgetReservation())
+		require.NoError(t, err)
+		require.Equal(t, instructions, flatten(groups))
+
+		for _, group := range groups {
+			tx, err := NewTransaction(append(computeBudget(), group...), Hash{}, TransactionPayer(payer))
+			require.NoError(t, err)
+			tx.Signatures = make([]Signature, tx.Message.Header.NumRequiredSignatures)
+			serialized, err := tx.MarshalBinary()
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(serialized), MaxTransactionSize)
+		}
+	}
+}
+
+func TestSplitInstructions_TooLarge(t *testing.T) {
+	payer := NewWallet().PublicKey()
+	_, err := SplitInstructions([]Instruction{
+		NewInstruction(
+			SystemProgramID,
+			AccountMetaSlice{Meta(payer).WRITE().SIGNER()},
+			make([]byte, MaxTransactionSize),
+		),
+	})
+	require.Error(t, err)
+}
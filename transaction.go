@@ -20,6 +20,7 @@ package solana
 import (
 	"bytes"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"sort"
 
@@ -55,6 +56,13 @@ func (t *Transaction) HasAccount(account PublicKey) bool     { return t.Message.
 func (t *Transaction) IsSigner(account PublicKey) bool       { return t.Message.IsSigner(account) }
 func (t *Transaction) IsWritable(account PublicKey) bool     { return t.Message.IsWritable(account) }
 func (t *Transaction) AccountMetaList() (out []*AccountMeta) { return t.Message.AccountMetaList() }
+
+// WritableAccounts returns the pubkeys of the accounts write-locked by the transaction
+// (e.g. the accounts to pass to getRecentPrioritizationFees).
+func (t *Transaction) WritableAccounts() (PublicKeySlice, error) { return t.Message.WritableAccounts() }
+
+// ReadonlyAccounts returns the pubkeys of the accounts read-locked by the transaction.
+func (t *Transaction) ReadonlyAccounts() (PublicKeySlice, error) { return t.Message.ReadonlyAccounts() }
 func (t *Transaction) ResolveProgramIDIndex(programIDIndex uint16) (PublicKey, error) {
 	return t.Message.ResolveProgramIDIndex(programIDIndex)
 }
@@ -68,6 +76,11 @@ func TransactionFromDecoder(decoder *bin.Decoder) (*Transaction, error) {
 	return out, nil
 }
 
+// TransactionFromBytes decodes a wire-format transaction.
+func TransactionFromBytes(data []byte) (*Transaction, error) {
+	return TransactionFromDecoder(bin.NewBinDecoder(data))
+}
+
 func MustTransactionFromDecoder(decoder *bin.Decoder) *Transaction {
 	out, err := TransactionFromDecoder(decoder)
 	if err != nil {
@@ -362,6 +375,9 @@ func (tx *Transaction) UnmarshalWithDecoder(decoder *bin.Decoder) (err error) {
 		if err != nil {
 			return fmt.Errorf("unable to read numSignatures: %w", err)
 		}
+		if err := checkCompactArrayLen(decoder, numSignatures, SignatureLength, "numSignatures"); err != nil {
+			return err
+		}
 
 		tx.Signatures = make([]Signature, numSignatures)
 		for i := 0; i < numSignatures; i++ {
@@ -381,6 +397,55 @@ func (tx *Transaction) UnmarshalWithDecoder(decoder *bin.Decoder) (err error) {
 }
 
 func (tx *Transaction) PartialSign(getter privateKeyGetter) (out []Signature, err error) {
+	return tx.partialSign(func(key PublicKey) Signer {
+		if privateKey := getter(key); privateKey != nil {
+			return *privateKey
+		}
+		return nil
+	})
+}
+
+func (tx *Transaction) Sign(getter privateKeyGetter) (out []Signature, err error) {
+	signerKeys := tx.Message.signerKeys()
+	for _, key := range signerKeys {
+		if getter(key) == nil {
+			return nil, fmt.Errorf("signer key %q not found. Ensure all the signer keys are in the vault", key.String())
+		}
+	}
+	return tx.PartialSign(getter)
+}
+
+// PartialSignWith signs the transaction with the provided signers;
+// signer keys of the message that don't have a matching signer are skipped.
+func (tx *Transaction) PartialSignWith(signers ...Signer) (out []Signature, err error) {
+	return tx.partialSign(signerGetter(signers))
+}
+
+// SignWith signs the transaction with the provided signers;
+// an error is returned if any of the signer keys of the message
+// doesn't have a matching signer.
+func (tx *Transaction) SignWith(signers ...Signer) (out []Signature, err error) {
+	getter := signerGetter(signers)
+	for _, key := range tx.Message.signerKeys() {
+		if getter(key) == nil {
+			return nil, fmt.Errorf("signer for key %q not found", key.String())
+		}
+	}
+	return tx.partialSign(getter)
+}
+
+func signerGetter(signers []Signer) func(key PublicKey) Signer {
+	return func(key PublicKey) Signer {
+		for _, signer := range signers {
+			if signer != nil && signer.PublicKey().Equals(key) {
+				return signer
+			}
+		}
+		return nil
+	}
+}
+
+func (tx *Transaction) partialSign(getter func(key PublicKey) Signer) (out []Signature, err error) {
 	messageContent, err := tx.Message.MarshalBinary()
 	if err != nil {
 		return nil, fmt.Errorf("unable to encode message for signing: %w", err)
@@ -389,9 +454,9 @@ func (tx *Transaction) PartialSign(getter privateKeyGetter) (out []Signature, er
 
 	signedSignatures := []Signature{}
 	for _, key := range signerKeys {
-		privateKey := getter(key)
-		if privateKey != nil {
-			s, err := privateKey.Sign(messageContent)
+		signer := getter(key)
+		if signer != nil {
+			s, err := signer.Sign(messageContent)
 			if err != nil {
 				return nil, fmt.Errorf("failed to signed with key %q: %w", key.String(), err)
 			}
@@ -402,14 +467,43 @@ func (tx *Transaction) PartialSign(getter privateKeyGetter) (out []Signature, er
 	return tx.Signatures, nil
 }
 
-func (tx *Transaction) Sign(getter privateKeyGetter) (out []Signature, err error) {
-	signerKeys := tx.Message.signerKeys()
-	for _, key := range signerKeys {
-		if getter(key) == nil {
-			return nil, fmt.Errorf("signer key %q not found. Ensure all the signer keys are in the vault", key.String())
-		}
+// ErrTransactionNotSigned is returned when a signature is requested from an unsigned transaction.
+var ErrTransactionNotSigned = errors.New("transaction is not signed")
+
+// Signature returns the first signature of the transaction, i.e. the fee payer's one,
+// which is also the id of the transaction.
+// It can be used to track the transaction before it has been submitted.
+func (tx *Transaction) Signature() (Signature, error) {
+	if len(tx.Signatures) == 0 || tx.Signatures[0].IsZero() {
+		return Signature{}, ErrTransactionNotSigned
 	}
-	return tx.PartialSign(getter)
+	return tx.Signatures[0], nil
+}
+
+// Signers returns the public keys that must sign the transaction,
+// in the order of their signatures, i.e. the first `NumRequiredSignatures` account keys
+// (the fee payer first).
+// Signers are never loaded from address lookup tables, so this is also
+// well-defined for versioned transactions whose tables are not resolved.
+func (tx *Transaction) Signers() PublicKeySlice {
+	num := int(tx.Message.Header.NumRequiredSignatures)
+	if num > len(tx.Message.AccountKeys) {
+		num = len(tx.Message.AccountKeys)
+	}
+	out := make(PublicKeySlice, num)
+	copy(out, tx.Message.AccountKeys[:num])
+	return out
+}
+
+// SetRecentBlockhash replaces the recent blockhash of the transaction message.
+// Since the existing signatures no longer match the updated message,
+// they are removed: the transaction must be signed again afterwards.
+func (tx *Transaction) SetRecentBlockhash(hash Hash) {
+	if tx.Message.RecentBlockhash.Equals(hash) {
+		return
+	}
+	tx.Message.RecentBlockhash = hash
+	tx.Signatures = nil
 }
 
 func (tx *Transaction) EncodeTree(encoder *text.TreeEncoder) (int, error) {
@@ -545,3 +639,156 @@ func (tx *Transaction) VerifySignatures() error {
 
 	return nil
 }
+
+// Diff returns a human-readable list of the differences between tx and other
+// (version, header, recent blockhash, signatures, accounts, address table lookups, instructions);
+// it returns an empty list if the transactions are the same.
+// It can be used to verify that a transaction to be signed matches the expected one.
+func (tx *Transaction) Diff(other *Transaction) []string {
+	diffs := make([]string, 0)
+	if tx == nil || other == nil {
+		if tx != other {
+			diffs = append(diffs, "one of the transactions is nil")
+		}
+		return diffs
+	}
+	addf := func(format string, args ...interface{}) {
+		diffs = append(diffs, fmt.Sprintf(format, args...))
+	}
+
+	a, b := tx.Message, other.Message
+	if a.GetVersion() != b.GetVersion() {
+		addf("version: %v != %v", a.GetVersion(), b.GetVersion())
+	}
+	if a.Header != b.Header {
+		addf("header: %+v != %+v", a.Header, b.Header)
+	}
+	if !a.RecentBlockhash.Equals(b.RecentBlockhash) {
+		addf("recent blockhash: %s != %s", a.RecentBlockhash, b.RecentBlockhash)
+	}
+
+	if len(tx.Signatures) != len(other.Signatures) {
+		addf("signatures count: %d != %d", len(tx.Signatures), len(other.Signatures))
+	}
+	for i := 0; i < len(tx.Signatures) && i < len(other.Signatures); i++ {
+		if !tx.Signatures[i].Equals(other.Signatures[i]) {
+			addf("signature [%d]: %s != %s", i, tx.Signatures[i], other.Signatures[i])
+		}
+	}
+
+	{
+		metasA, metasB := a.staticAccountMetas(), b.staticAccountMetas()
+		byKeyB := make(map[PublicKey]*AccountMeta, len(metasB))
+		for _, meta := range metasB {
+			byKeyB[meta.PublicKey] = meta
+		}
+		byKeyA := make(map[PublicKey]*AccountMeta, len(metasA))
+		for _, meta := range metasA {
+			byKeyA[meta.PublicKey] = meta
+			otherMeta, ok := byKeyB[meta.PublicKey]
+			if !ok {
+				addf("account %s: only in this transaction", meta.PublicKey)
+				continue
+			}
+			if !meta.Equals(otherMeta) {
+				addf(
+					"account %s: (writable=%v, signer=%v) != (writable=%v, signer=%v)",
+					meta.PublicKey,
+					meta.IsWritable, meta.IsSigner,
+					otherMeta.IsWritable, otherMeta.IsSigner,
+				)
+			}
+		}
+		for _, meta := range metasB {
+			if _, ok := byKeyA[meta.PublicKey]; !ok {
+				addf("account %s: only in the other transaction", meta.PublicKey)
+			}
+		}
+	}
+
+	if len(a.addressTableLookups) != len(b.addressTableLookups) {
+		addf("address table lookups count: %d != %d", len(a.addressTableLookups), len(b.addressTableLookups))
+	}
+	for i := 0; i < len(a.addressTableLookups) && i < len(b.addressTableLookups); i++ {
+		lookupA, lookupB := a.addressTableLookups[i], b.addressTableLookups[i]
+		if !lookupA.AccountKey.Equals(lookupB.AccountKey) {
+			addf("address table lookup [%d] table: %s != %s", i, lookupA.AccountKey, lookupB.AccountKey)
+		}
+		if !bytes.Equal(lookupA.WritableIndexes, lookupB.WritableIndexes) {
+			addf("address table lookup [%d] writable indexes: %v != %v", i, lookupA.WritableIndexes, lookupB.WritableIndexes)
+		}
+		if !bytes.Equal(lookupA.ReadonlyIndexes, lookupB.ReadonlyIndexes) {
+			addf("address table lookup [%d] readonly indexes: %v != %v", i, lookupA.ReadonlyIndexes, lookupB.ReadonlyIndexes)
+		}
+	}
+
+	if len(a.Instructions) != len(b.Instructions) {
+		addf("instructions count: %d != %d", len(a.Instructions), len(b.Instructions))
+	}
+	for i := 0; i < len(a.Instructions) && i < len(b.Instructions); i++ {
+		insA, insB := a.Instructions[i], b.Instructions[i]
+		if progA, progB := a.keyAtIndex(insA.ProgramIDIndex), b.keyAtIndex(insB.ProgramIDIndex); progA != progB {
+			addf("instruction [%d] program: %s != %s", i, progA, progB)
+		}
+		if accsA, accsB := a.keysAtIndexes(insA.Accounts), b.keysAtIndexes(insB.Accounts); !stringSlicesEqual(accsA, accsB) {
+			addf("instruction [%d] accounts: %v != %v", i, accsA, accsB)
+		}
+		if !bytes.Equal(insA.Data, insB.Data) {
+			addf("instruction [%d] data: %x != %x", i, []byte(insA.Data), []byte(insB.Data))
+		}
+	}
+	return diffs
+}
+
+// staticAccountMetas returns the metas of the static accounts in AccountKeys,
+// with the flags computed from the header
+// (without the accounts loaded from address tables).
+func (m Message) staticAccountMetas() []*AccountMeta {
+	h := m.Header
+	keys := m.AccountKeys
+	if m.IsVersioned() && m.addressTables != nil {
+		// SetAddressTables appends the accounts loaded from the tables.
+		if numStatic := len(keys) - m.addressTableLookups.NumLookups(); numStatic >= 0 {
+			keys = keys[:numStatic]
+		}
+	}
+	out := make([]*AccountMeta, len(keys))
+	for index, key := range keys {
+		out[index] = &AccountMeta{
+			PublicKey: key,
+			IsSigner:  index < int(h.NumRequiredSignatures),
+			IsWritable: (index < int(h.NumRequiredSignatures)-int(h.NumReadonlySignedAccounts)) ||
+				((index >= int(h.NumRequiredSignatures)) && (index < len(keys)-int(h.NumReadonlyUnsignedAccounts))),
+		}
+	}
+	return out
+}
+
+// keyAtIndex returns the string representation of the account at the provided index,
+// or the index itself if it's not one of the AccountKeys (e.g. it's loaded from an address table).
+func (m Message) keyAtIndex(index uint16) string {
+	if int(index) < len(m.AccountKeys) {
+		return m.AccountKeys[index].String()
+	}
+	return fmt.Sprintf("#%d", index)
+}
+
+func (m Message) keysAtIndexes(indexes []uint16) []string {
+	out := make([]string, len(indexes))
+	for i, index := range indexes {
+		out[i] = m.keyAtIndex(index)
+	}
+	return out
+}
+
+func stringSlicesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -107,6 +107,144 @@ func TestNewTransaction(t *testing.T) {
 	})
 }
 
+func TestTransactionBuilder_Header(t *testing.T) {
+	payer := MustPublicKeyFromBase58("A9QnpgfhCkmiBSjgBuWk76Wo3HxzxvDopUq9x6UUMmjn")
+	recipient := MustPublicKeyFromBase58("9hFtYBYmBJCVguRYs9pBTWKYAFoKfjYR7zBPpEkVsmD")
+	authority := MustPublicKeyFromBase58("6FzXPEhCJoBx7Zw3SN9qhekHemd6E2b8kVguitmVAngW")
+
+	blockhash, err := HashFromBase58("A9QnpgfhCkmiBSjgBuWk76Wo3HxzxvDopUq9x6UUMmjn")
+	require.NoError(t, err)
+
+	builder := NewTransactionBuilder().
+		SetRecentBlockHash(blockhash).
+		AddInstruction(&testTransactionInstructions{
+			accounts: []*AccountMeta{
+				{PublicKey: payer, IsSigner: true, IsWritable: true},
+				{PublicKey: recipient, IsSigner: false, IsWritable: true},
+			},
+			data:      []byte{0x01},
+			programID: SystemProgramID,
+		})
+
+	trx, err := builder.Build()
+	require.NoError(t, err)
+	// payer is a writable signer; recipient is writable;
+	// the system program is read-only.
+	assert.Equal(t, MessageHeader{
+		NumRequiredSignatures:       1,
+		NumReadonlySignedAccounts:   0,
+		NumReadonlyUnsignedAccounts: 1,
+	}, trx.Message.Header)
+
+	// Adding an instruction with a read-only signer, a read-only account and another program
+	// must be reflected in the header.
+	builder.AddInstruction(&testTransactionInstructions{
+		accounts: []*AccountMeta{
+			{PublicKey: authority, IsSigner: true, IsWritable: false},
+			{PublicKey: SysVarClockPubkey, IsSigner: false, IsWritable: false},
+		},
+		data:      []byte{0x02},
+		programID: MemoProgramID,
+	})
+
+	trx, err = builder.Build()
+	require.NoError(t, err)
+	assert.Equal(t, MessageHeader{
+		NumRequiredSignatures:       2,
+		NumReadonlySignedAccounts:   1,
+		NumReadonlyUnsignedAccounts: 3,
+	}, trx.Message.Header)
+	assert.Equal(t, PublicKeySlice{payer, authority}, trx.Message.Signers())
+}
+
+func TestTransactionBuilder_SetFeePayer(t *testing.T) {
+	feePayer := MustPublicKeyFromBase58("A9QnpgfhCkmiBSjgBuWk76Wo3HxzxvDopUq9x6UUMmjn")
+	authority := MustPublicKeyFromBase58("6FzXPEhCJoBx7Zw3SN9qhekHemd6E2b8kVguitmVAngW")
+	recipient := MustPublicKeyFromBase58("9hFtYBYmBJCVguRYs9pBTWKYAFoKfjYR7zBPpEkVsmD")
+
+	blockhash, err := HashFromBase58("A9QnpgfhCkmiBSjgBuWk76Wo3HxzxvDopUq9x6UUMmjn")
+	require.NoError(t, err)
+
+	// The instruction's signer would be the first account without an explicit fee payer.
+	instruction := &testTransactionInstructions{
+		accounts: []*AccountMeta{
+			{PublicKey: authority, IsSigner: true, IsWritable: true},
+			{PublicKey: recipient, IsSigner: false, IsWritable: true},
+		},
+		data:      []byte{0x01},
+		programID: SystemProgramID,
+	}
+
+	trx, err := NewTransactionBuilder().
+		SetRecentBlockHash(blockhash).
+		SetFeePayer(feePayer).
+		AddInstruction(instruction).
+		Build()
+	require.NoError(t, err)
+
+	require.Equal(t, feePayer, trx.Message.AccountKeys[0])
+	require.Equal(t, PublicKeySlice{feePayer, authority}, trx.Message.Signers())
+	require.True(t, trx.Message.IsWritable(feePayer))
+	assert.Equal(t, MessageHeader{
+		NumRequiredSignatures:       2,
+		NumReadonlySignedAccounts:   0,
+		NumReadonlyUnsignedAccounts: 1,
+	}, trx.Message.Header)
+	// The instruction accounts are still resolved to the right keys.
+	assert.Equal(t, []uint16{1, 2}, trx.Message.Instructions[0].Accounts)
+
+	// A fee payer that is also a read-only, non-signer account of an instruction
+	// is promoted to a writable signer at index 0.
+	instruction.accounts = append(instruction.accounts, &AccountMeta{PublicKey: feePayer})
+	trx, err = NewTransactionBuilder().
+		SetRecentBlockHash(blockhash).
+		SetFeePayer(feePayer).
+		AddInstruction(instruction).
+		Build()
+	require.NoError(t, err)
+	require.Equal(t, feePayer, trx.Message.AccountKeys[0])
+	require.Equal(t, PublicKeySlice{feePayer, authority}, trx.Message.Signers())
+	require.True(t, trx.Message.IsWritable(feePayer))
+	assert.Equal(t, []uint16{1, 2, 0}, trx.Message.Instructions[0].Accounts)
+}
+
+func TestTransactionWritableAccounts(t *testing.T) {
+	payer := MustPublicKeyFromBase58("A9QnpgfhCkmiBSjgBuWk76Wo3HxzxvDopUq9x6UUMmjn")
+	recipient := MustPublicKeyFromBase58("9hFtYBYmBJCVguRYs9pBTWKYAFoKfjYR7zBPpEkVsmD")
+
+	blockhash, err := HashFromBase58("A9QnpgfhCkmiBSjgBuWk76Wo3HxzxvDopUq9x6UUMmjn")
+	require.NoError(t, err)
+
+	trx, err := NewTransaction(
+		[]Instruction{
+			// SetComputeUnitPrice
+			&testTransactionInstructions{
+				data:      []byte{0x03, 0x40, 0x42, 0x0f, 0x00, 0x00, 0x00, 0x00, 0x00},
+				programID: ComputeBudget,
+			},
+			// Transfer
+			&testTransactionInstructions{
+				accounts: []*AccountMeta{
+					{PublicKey: payer, IsSigner: true, IsWritable: true},
+					{PublicKey: recipient, IsSigner: false, IsWritable: true},
+				},
+				data:      []byte{0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+				programID: SystemProgramID,
+			},
+		},
+		blockhash,
+		TransactionPayer(payer),
+	)
+	require.NoError(t, err)
+
+	writable, err := trx.WritableAccounts()
+	require.NoError(t, err)
+	require.Equal(t, PublicKeySlice{payer, recipient}, writable)
+	readonly, err := trx.ReadonlyAccounts()
+	require.NoError(t, err)
+	require.ElementsMatch(t, PublicKeySlice{ComputeBudget, SystemProgramID}, readonly)
+}
+
 func TestPartialSignTransaction(t *testing.T) {
 	signers := []PrivateKey{
 		NewWallet().PrivateKey,
@@ -189,6 +327,223 @@ func TestSignTransaction(t *testing.T) {
 	})
 }
 
+type recordingSigner struct {
+	key      PrivateKey
+	messages [][]byte
+}
+
+func (s *recordingSigner) PublicKey() PublicKey {
+	return s.key.PublicKey()
+}
+
+func (s *recordingSigner) Sign(message []byte) (Signature, error) {
+	s.messages = append(s.messages, message)
+	return s.key.Sign(message)
+}
+
+func TestSignTransactionWithSigners(t *testing.T) {
+	remote := &recordingSigner{key: NewWallet().PrivateKey}
+	local := NewWallet().PrivateKey
+
+	instructions := []Instruction{
+		&testTransactionInstructions{
+			accounts: []*AccountMeta{
+				{PublicKey: remote.PublicKey(), IsSigner: true, IsWritable: true},
+				{PublicKey: local.PublicKey(), IsSigner: true, IsWritable: false},
+			},
+			data:      []byte{0xaa, 0xbb},
+			programID: MustPublicKeyFromBase58("11111111111111111111111111111111"),
+		},
+	}
+
+	blockhash, err := HashFromBase58("A9QnpgfhCkmiBSjgBuWk76Wo3HxzxvDopUq9x6UUMmjn")
+	require.NoError(t, err)
+
+	t.Run("should reject missing signer(s)", func(t *testing.T) {
+		trx, err := NewTransaction(instructions, blockhash)
+		require.NoError(t, err)
+
+		_, err = trx.SignWith(remote)
+		require.Error(t, err)
+		require.Empty(t, remote.messages)
+	})
+
+	t.Run("should partially sign with signer(s)", func(t *testing.T) {
+		trx, err := NewTransaction(instructions, blockhash)
+		require.NoError(t, err)
+
+		signatures, err := trx.PartialSignWith(local)
+		require.NoError(t, err)
+		require.Len(t, signatures, 1)
+	})
+
+	t.Run("should sign with signer(s)", func(t *testing.T) {
+		remote.messages = nil
+		trx, err := NewTransaction(instructions, blockhash)
+		require.NoError(t, err)
+
+		signatures, err := trx.SignWith(local, remote)
+		require.NoError(t, err)
+		require.Len(t, signatures, 2)
+
+		messageContent, err := trx.Message.MarshalBinary()
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{messageContent}, remote.messages)
+		require.NoError(t, trx.VerifySignatures())
+	})
+}
+
+func TestTransactionSetRecentBlockhash(t *testing.T) {
+	signer := NewWallet().PrivateKey
+	instructions := []Instruction{
+		&testTransactionInstructions{
+			accounts: []*AccountMeta{
+				{PublicKey: signer.PublicKey(), IsSigner: true, IsWritable: true},
+			},
+			data:      []byte{0xaa, 0xbb},
+			programID: MustPublicKeyFromBase58("11111111111111111111111111111111"),
+		},
+	}
+
+	blockhash := MustHashFromBase58("A9QnpgfhCkmiBSjgBuWk76Wo3HxzxvDopUq9x6UUMmjn")
+	trx, err := NewTransaction(instructions, blockhash)
+	require.NoError(t, err)
+
+	_, err = trx.SignWith(signer)
+	require.NoError(t, err)
+	require.Len(t, trx.Signatures, 1)
+
+	// Same blockhash: signatures are still valid.
+	trx.SetRecentBlockhash(blockhash)
+	require.Len(t, trx.Signatures, 1)
+	require.NoError(t, trx.VerifySignatures())
+
+	newBlockhash := MustHashFromBase58("DvLEyV2GHk86K5GojpqnRsvhfMF5kdZomKMnhVpvHyqK")
+	trx.SetRecentBlockhash(newBlockhash)
+	require.Equal(t, newBlockhash, trx.Message.RecentBlockhash)
+	require.Empty(t, trx.Signatures)
+
+	_, err = trx.SignWith(signer)
+	require.NoError(t, err)
+	require.Len(t, trx.Signatures, 1)
+	require.NoError(t, trx.VerifySignatures())
+}
+
+func TestTransactionSignature(t *testing.T) {
+	signer := NewWallet().PrivateKey
+	trx, err := NewTransaction(
+		[]Instruction{
+			&testTransactionInstructions{
+				accounts: []*AccountMeta{
+					{PublicKey: signer.PublicKey(), IsSigner: true, IsWritable: true},
+				},
+				data:      []byte{0xaa, 0xbb},
+				programID: MustPublicKeyFromBase58("11111111111111111111111111111111"),
+			},
+		},
+		MustHashFromBase58("A9QnpgfhCkmiBSjgBuWk76Wo3HxzxvDopUq9x6UUMmjn"),
+	)
+	require.NoError(t, err)
+
+	_, err = trx.Signature()
+	require.ErrorIs(t, err, ErrTransactionNotSigned)
+
+	signatures, err := trx.SignWith(signer)
+	require.NoError(t, err)
+
+	sig, err := trx.Signature()
+	require.NoError(t, err)
+	require.Equal(t, signatures[0], sig)
+}
+
+func TestTransactionSigners(t *testing.T) {
+	feePayer := NewWallet().PublicKey()
+	signerA := NewWallet().PublicKey()
+	signerB := NewWallet().PublicKey()
+	readonly := NewWallet().PublicKey()
+	trx, err := NewTransaction(
+		[]Instruction{
+			&testTransactionInstructions{
+				accounts: []*AccountMeta{
+					{PublicKey: readonly, IsSigner: false, IsWritable: false},
+					{PublicKey: signerA, IsSigner: true, IsWritable: false},
+					{PublicKey: signerB, IsSigner: true, IsWritable: true},
+				},
+				data:      []byte{0xaa, 0xbb},
+				programID: MustPublicKeyFromBase58("11111111111111111111111111111111"),
+			},
+		},
+		MustHashFromBase58("A9QnpgfhCkmiBSjgBuWk76Wo3HxzxvDopUq9x6UUMmjn"),
+		TransactionPayer(feePayer),
+	)
+	require.NoError(t, err)
+
+	signers := trx.Signers()
+	require.Len(t, signers, 3)
+	require.Equal(t, feePayer, signers[0])
+	require.ElementsMatch(t, PublicKeySlice{feePayer, signerA, signerB}, signers)
+	require.Equal(t, PublicKeySlice(trx.Message.AccountKeys[:3]), signers)
+	require.False(t, signers.Has(readonly))
+
+	// The returned slice is a copy.
+	signers[0] = readonly
+	require.Equal(t, feePayer, trx.Message.AccountKeys[0])
+}
+
+func TestMessageDecompileInstructions(t *testing.T) {
+	feePayer := NewWallet().PublicKey()
+	signer := NewWallet().PublicKey()
+	writable := NewWallet().PublicKey()
+	readonly := NewWallet().PublicKey()
+	programA := NewWallet().PublicKey()
+	programB := NewWallet().PublicKey()
+	instructions := []Instruction{
+		NewInstruction(
+			programA,
+			AccountMetaSlice{
+				{PublicKey: feePayer, IsSigner: true, IsWritable: true},
+				{PublicKey: writable, IsSigner: false, IsWritable: true},
+				{PublicKey: readonly, IsSigner: false, IsWritable: false},
+			},
+			[]byte{0xaa, 0xbb},
+		),
+		NewInstruction(
+			programB,
+			AccountMetaSlice{
+				{PublicKey: signer, IsSigner: true, IsWritable: false},
+				{PublicKey: readonly, IsSigner: false, IsWritable: false},
+			},
+			[]byte{0xcc},
+		),
+		NewInstruction(programA, AccountMetaSlice{}, []byte{}),
+	}
+
+	trx, err := NewTransaction(instructions, MustHashFromBase58("A9QnpgfhCkmiBSjgBuWk76Wo3HxzxvDopUq9x6UUMmjn"), TransactionPayer(feePayer))
+	require.NoError(t, err)
+
+	got, err := trx.Message.DecompileInstructions()
+	require.NoError(t, err)
+	require.Len(t, got, len(instructions))
+	for i, inst := range instructions {
+		require.Equal(t, inst.ProgramID(), got[i].ProgramID(), "instruction %d", i)
+		require.Equal(t, inst.Accounts(), got[i].Accounts(), "instruction %d", i)
+		expectedData, err := inst.Data()
+		require.NoError(t, err)
+		gotData, err := got[i].Data()
+		require.NoError(t, err)
+		require.Equal(t, expectedData, gotData, "instruction %d", i)
+	}
+
+	// Compiling the decompiled instructions gives back the same message.
+	recompiled, err := NewTransaction(got, trx.Message.RecentBlockhash, TransactionPayer(feePayer))
+	require.NoError(t, err)
+	require.Equal(t, trx.Message, recompiled.Message)
+
+	trx.Message.Instructions[0].Accounts[0] = 200
+	_, err = trx.Message.DecompileInstructions()
+	require.Error(t, err)
+}
+
 func TestTransactionDecode(t *testing.T) {
 	encoded := "AfjEs3XhTc3hrxEvlnMPkm/cocvAUbFNbCl00qKnrFue6J53AhEqIFmcJJlJW3EDP5RmcMz+cNTTcZHW/WJYwAcBAAEDO8hh4VddzfcO5jbCt95jryl6y8ff65UcgukHNLWH+UQGgxCGGpgyfQVQV02EQYqm4QwzUt2qf9f1gVLM7rI4hwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA6ANIF55zOZWROWRkeh+lExxZBnKFqbvIxZDLE7EijjoBAgIAAQwCAAAAOTAAAAAAAAA="
 	data, err := base64.StdEncoding.DecodeString(encoded)
@@ -244,6 +599,45 @@ func TestTransactionDecode(t *testing.T) {
 	)
 }
 
+func TestTransactionDecode_OversizedLengthPrefix(t *testing.T) {
+	encoded := "AfjEs3XhTc3hrxEvlnMPkm/cocvAUbFNbCl00qKnrFue6J53AhEqIFmcJJlJW3EDP5RmcMz+cNTTcZHW/WJYwAcBAAEDO8hh4VddzfcO5jbCt95jryl6y8ff65UcgukHNLWH+UQGgxCGGpgyfQVQV02EQYqm4QwzUt2qf9f1gVLM7rI4hwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA6ANIF55zOZWROWRkeh+lExxZBnKFqbvIxZDLE7EijjoBAgIAAQwCAAAAOTAAAAAAAAA="
+	valid, err := base64.StdEncoding.DecodeString(encoded)
+	require.NoError(t, err)
+
+	{
+		// 65535 signatures declared, followed by a few bytes:
+		data := []byte{0xff, 0xff, 0x03, 1, 2, 3}
+		_, err := TransactionFromBytes(data)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid numSignatures")
+	}
+	{
+		// A valid signature, then a message declaring 65535 account keys:
+		data := append([]byte{}, valid[:1+64+3]...)
+		data = append(data, 0xff, 0xff, 0x03)
+		data = append(data, valid[1+64+3+1:]...)
+		_, err := TransactionFromBytes(data)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid numAccountKeys")
+	}
+	{
+		// The instruction declaring 65535 accounts:
+		numAccountsOffset := len(valid) - 16
+		require.Equal(t, []byte{1, 2, 2, 0, 1, 12}, valid[numAccountsOffset-2:numAccountsOffset+4])
+		data := append([]byte{}, valid[:numAccountsOffset]...)
+		data = append(data, 0xff, 0xff, 0x03)
+		data = append(data, valid[numAccountsOffset+1:]...)
+		_, err := TransactionFromBytes(data)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid numAccounts for ix[0]")
+	}
+	{
+		tx, err := TransactionFromBytes(valid)
+		require.NoError(t, err)
+		require.Len(t, tx.Message.Instructions, 1)
+	}
+}
+
 func TestTransactionVerifySignatures(t *testing.T) {
 	type testCase struct {
 		Transaction string
@@ -312,3 +706,90 @@ func BenchmarkTransactionVerifySignatures(b *testing.B) {
 		tx.VerifySignatures()
 	}
 }
+
+func TestTransactionDiff(t *testing.T) {
+	payer := MustPublicKeyFromBase58("A9QnpgfhCkmiBSjgBuWk76Wo3HxzxvDopUq9x6UUMmjn")
+	recipient := MustPublicKeyFromBase58("9hFtYBYmBJCVguRYs9pBTWKYAFoKfjYR7zBPpEkVsmD")
+	other := MustPublicKeyFromBase58("6FzXPEhCJoBx7Zw3SN9qhekHemd6E2b8kVguitmVAngW")
+
+	blockhash := MustHashFromBase58("A9QnpgfhCkmiBSjgBuWk76Wo3HxzxvDopUq9x6UUMmjn")
+	otherBlockhash := MustHashFromBase58("6o9C27iJ5rPi7wEpvQu1cFbB1WnRudtsPnbY8GvFWrgR")
+
+	build := func(to PublicKey, data []byte, hash Hash) *Transaction {
+		trx, err := NewTransaction(
+			[]Instruction{
+				&testTransactionInstructions{
+					accounts: []*AccountMeta{
+						Meta(payer).WRITE().SIGNER(),
+						Meta(to).WRITE(),
+					},
+					data:      data,
+					programID: SystemProgramID,
+				},
+			},
+			hash,
+		)
+		require.NoError(t, err)
+		return trx
+	}
+
+	expected := build(recipient, []byte{0x02, 0x01}, blockhash)
+	require.Empty(t, expected.Diff(build(recipient, []byte{0x02, 0x01}, blockhash)))
+
+	got := build(other, []byte{0x02, 0x02}, otherBlockhash)
+	assert.Equal(t,
+		[]string{
+			"recent blockhash: A9QnpgfhCkmiBSjgBuWk76Wo3HxzxvDopUq9x6UUMmjn != 6o9C27iJ5rPi7wEpvQu1cFbB1WnRudtsPnbY8GvFWrgR",
+			"account 9hFtYBYmBJCVguRYs9pBTWKYAFoKfjYR7zBPpEkVsmD: only in this transaction",
+			"account 6FzXPEhCJoBx7Zw3SN9qhekHemd6E2b8kVguitmVAngW: only in the other transaction",
+			"instruction [0] accounts: [A9QnpgfhCkmiBSjgBuWk76Wo3HxzxvDopUq9x6UUMmjn 9hFtYBYmBJCVguRYs9pBTWKYAFoKfjYR7zBPpEkVsmD] != [A9QnpgfhCkmiBSjgBuWk76Wo3HxzxvDopUq9x6UUMmjn 6FzXPEhCJoBx7Zw3SN9qhekHemd6E2b8kVguitmVAngW]",
+			"instruction [0] data: 0201 != 0202",
+		},
+		expected.Diff(got),
+	)
+
+	// A signature and a writability change:
+	signed := build(recipient, []byte{0x02, 0x01}, blockhash)
+	signed.Signatures = []Signature{{1}}
+	readonly, err := NewTransaction(
+		[]Instruction{
+			&testTransactionInstructions{
+				accounts: []*AccountMeta{
+					Meta(payer).WRITE().SIGNER(),
+					Meta(recipient),
+				},
+				data:      []byte{0x02, 0x01},
+				programID: SystemProgramID,
+			},
+		},
+		blockhash,
+	)
+	require.NoError(t, err)
+	assert.Equal(t,
+		[]string{
+			"header: {NumRequiredSignatures:1 NumReadonlySignedAccounts:0 NumReadonlyUnsignedAccounts:1} != {NumRequiredSignatures:1 NumReadonlySignedAccounts:0 NumReadonlyUnsignedAccounts:2}",
+			"signatures count: 1 != 0",
+			"account 9hFtYBYmBJCVguRYs9pBTWKYAFoKfjYR7zBPpEkVsmD: (writable=true, signer=false) != (writable=false, signer=false)",
+		},
+		signed.Diff(readonly),
+	)
+
+	// Lookups of different accounts from the same address table:
+	table := MustPublicKeyFromBase58("2immgwYNHBbyVQKVGCEkgWpi53bLwWNRMB5G2nbgYV17")
+	withLookup := func(writable, readonly []uint8) *Transaction {
+		trx := build(recipient, []byte{0x02, 0x01}, blockhash)
+		trx.Message.SetVersion(MessageVersionV0)
+		trx.Message.SetAddressTableLookups([]MessageAddressTableLookup{
+			{AccountKey: table, WritableIndexes: writable, ReadonlyIndexes: readonly},
+		})
+		return trx
+	}
+	require.Empty(t, withLookup([]uint8{0}, []uint8{1}).Diff(withLookup([]uint8{0}, []uint8{1})))
+	assert.Equal(t,
+		[]string{
+			"address table lookup [0] writable indexes: [0] != [2]",
+			"address table lookup [0] readonly indexes: [1] != [3]",
+		},
+		withLookup([]uint8{0}, []uint8{1}).Diff(withLookup([]uint8{2}, []uint8{3})),
+	)
+}
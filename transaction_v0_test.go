@@ -72,3 +72,112 @@ func TestTransactionV0(t *testing.T) {
 		require.Equal(t, txB64, encoded)
 	}
 }
+
+func TestTransactionWritableAccountsV0(t *testing.T) {
+	txB64 := "Alkhq/BfGdBeok4oBP21xAwT4oO/R5PvkKqbCTq4sHHRsto+uDQCFcdp8hXh1g5D3mTh8GAJW8xE+EDD27f9IweTkH2Afiu4h5aM+Xbo0mklc0/Vi1xawd7SZVbstXDLtWdoJaf4Zt+20F/SasURzw/P4dkD+Q6BjgUNHT+vg5gOgAIBAQUaJV0Ch/DG6XwNcizWbI7STLgSbIOrg0Dl67Oo30WU1uA/NIbYLPRmuLarIJ4J0CcN3IWEm4Gf8675KhnXef2LaDXzjFgWVSbAO2yyTF6dK1oO3gTExie957LXDwu6oJMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAVKU1qZKSEGTSTocWDaOHx8NbXdvJK7geQfqEBBBUSNlyFnQmYh1aMkGtq3c6TIOsk32S6XMUnN9DQgFGQq4lwEAwIAAgwCAAAAgJaYAAAAAAADAgAFDAIAAACAlpgAAAAAAAMCAAYMAgAAAICWmAAAAAAABAAMSGVsbG8gRmFiaW8hAX5s37FH6IeB4QeMYxD4LtpXf1DaupH/ro7W+kEQnofaAgECAQA="
+
+	tx := new(Transaction)
+	require.NoError(t, tx.UnmarshalBase64(txB64))
+	require.NoError(t, tx.Message.SetAddressTables(map[PublicKey][]PublicKey{
+		MPK("9WWfC3y4uCNofr2qEFHSVUXkCxW99JiYkMWmSZvVt8j3"): {
+			MPK("2jGpE3ADYRoJPMjyGC4tvqqDfobvdvwGr3vhd66zA1rc"),
+			MPK("FKN5imdi7yadX4axe4hxaqBET4n6DBDRF5LKo5aBF53j"),
+			MPK("3or4uF7ZyuQW5GGmcmdXDJasNiSZUURF2az1UrRPYQTg"),
+			MPK("MemoSq4gqABAXKb96qnH8TysNcWxMyWCqXgDLGmfcHr"),
+		},
+	}))
+
+	writable, err := tx.WritableAccounts()
+	require.NoError(t, err)
+	readonly, err := tx.ReadonlyAccounts()
+	require.NoError(t, err)
+	require.Equal(t,
+		PublicKeySlice{
+			MPK("2m4eNwBVqu6SgFk23HgE3W5MW89yT5z1vspz2WsiFBHF"),
+			MPK("81o7hHYN5a8fc5wdjjfznK9ziJ9wcuKXwbZnuYpanxMQ"),
+			MPK("FKN5imdi7yadX4axe4hxaqBET4n6DBDRF5LKo5aBF53j"), // from address table
+			MPK("3or4uF7ZyuQW5GGmcmdXDJasNiSZUURF2az1UrRPYQTg"), // from address table
+		},
+		writable,
+	)
+	require.Equal(t,
+		PublicKeySlice{
+			MPK("G6NDx85GM481GPjT5kUBAvjLxzDMsgRMQ1EAxzGswEJn"),
+			MPK("11111111111111111111111111111111"),
+			MPK("MemoSq4gqABAXKb96qnH8TysNcWxMyWCqXgDLGmfcHr"),
+			MPK("2jGpE3ADYRoJPMjyGC4tvqqDfobvdvwGr3vhd66zA1rc"), // from address table
+		},
+		readonly,
+	)
+
+	// A lookup added afterwards, from a table that was not provided.
+	tx.Message.AddAddressTableLookup(MessageAddressTableLookup{
+		AccountKey:      MPK("2immgwYNHBbyVQKVGCEkgWpi53bLwWNRMB5G2nbgYV17"),
+		WritableIndexes: []uint8{0},
+	})
+	_, err = tx.WritableAccounts()
+	require.Error(t, err)
+	_, err = tx.ReadonlyAccounts()
+	require.Error(t, err)
+}
+
+func TestMessageDecompileInstructionsV0(t *testing.T) {
+	txB64 := "Alkhq/BfGdBeok4oBP21xAwT4oO/R5PvkKqbCTq4sHHRsto+uDQCFcdp8hXh1g5D3mTh8GAJW8xE+EDD27f9IweTkH2Afiu4h5aM+Xbo0mklc0/Vi1xawd7SZVbstXDLtWdoJaf4Zt+20F/SasURzw/P4dkD+Q6BjgUNHT+vg5gOgAIBAQUaJV0Ch/DG6XwNcizWbI7STLgSbIOrg0Dl67Oo30WU1uA/NIbYLPRmuLarIJ4J0CcN3IWEm4Gf8675KhnXef2LaDXzjFgWVSbAO2yyTF6dK1oO3gTExie957LXDwu6oJMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAVKU1qZKSEGTSTocWDaOHx8NbXdvJK7geQfqEBBBUSNlyFnQmYh1aMkGtq3c6TIOsk32S6XMUnN9DQgFGQq4lwEAwIAAgwCAAAAgJaYAAAAAAADAgAFDAIAAACAlpgAAAAAAAMCAAYMAgAAAICWmAAAAAAABAAMSGVsbG8gRmFiaW8hAX5s37FH6IeB4QeMYxD4LtpXf1DaupH/ro7W+kEQnofaAgECAQA="
+
+	payer := MPK("2m4eNwBVqu6SgFk23HgE3W5MW89yT5z1vspz2WsiFBHF")
+	expected := []AccountMetaSlice{
+		{Meta(payer).WRITE().SIGNER(), Meta(MPK("81o7hHYN5a8fc5wdjjfznK9ziJ9wcuKXwbZnuYpanxMQ")).WRITE()},
+		{Meta(payer).WRITE().SIGNER(), Meta(MPK("FKN5imdi7yadX4axe4hxaqBET4n6DBDRF5LKo5aBF53j")).WRITE()}, // from address table
+		{Meta(payer).WRITE().SIGNER(), Meta(MPK("3or4uF7ZyuQW5GGmcmdXDJasNiSZUURF2az1UrRPYQTg")).WRITE()}, // from address table
+		{},
+	}
+	check := func(t *testing.T, got []Instruction) {
+		require.Len(t, got, 4)
+		for i := 0; i < 3; i++ {
+			require.Equal(t, SystemProgramID, got[i].ProgramID())
+			require.Equal(t, []*AccountMeta(expected[i]), got[i].Accounts())
+		}
+		require.Equal(t, MemoProgramID, got[3].ProgramID())
+		require.Empty(t, got[3].Accounts())
+		data, err := got[3].Data()
+		require.NoError(t, err)
+		require.Equal(t, "Hello Fabio!", string(data))
+	}
+
+	t.Run("loaded addresses", func(t *testing.T) {
+		tx := new(Transaction)
+		require.NoError(t, tx.UnmarshalBase64(txB64))
+
+		_, err := tx.Message.DecompileInstructions()
+		require.Error(t, err)
+
+		got, err := tx.Message.DecompileInstructionsWithLoadedAddresses(
+			PublicKeySlice{
+				MPK("FKN5imdi7yadX4axe4hxaqBET4n6DBDRF5LKo5aBF53j"),
+				MPK("3or4uF7ZyuQW5GGmcmdXDJasNiSZUURF2az1UrRPYQTg"),
+			},
+			PublicKeySlice{
+				MPK("2jGpE3ADYRoJPMjyGC4tvqqDfobvdvwGr3vhd66zA1rc"),
+			},
+		)
+		require.NoError(t, err)
+		check(t, got)
+	})
+
+	t.Run("address tables", func(t *testing.T) {
+		tx := new(Transaction)
+		require.NoError(t, tx.UnmarshalBase64(txB64))
+		require.NoError(t, tx.Message.SetAddressTables(map[PublicKey][]PublicKey{
+			MPK("9WWfC3y4uCNofr2qEFHSVUXkCxW99JiYkMWmSZvVt8j3"): {
+				MPK("2jGpE3ADYRoJPMjyGC4tvqqDfobvdvwGr3vhd66zA1rc"),
+				MPK("FKN5imdi7yadX4axe4hxaqBET4n6DBDRF5LKo5aBF53j"),
+				MPK("3or4uF7ZyuQW5GGmcmdXDJasNiSZUURF2az1UrRPYQTg"),
+				MPK("MemoSq4gqABAXKb96qnH8TysNcWxMyWCqXgDLGmfcHr"),
+			},
+		}))
+
+		got, err := tx.Message.DecompileInstructions()
+		require.NoError(t, err)
+		check(t, got)
+	})
+}
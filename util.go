@@ -15,6 +15,8 @@
 package solana
 
 import (
+	"errors"
+	"fmt"
 	"math/big"
 )
 
@@ -53,6 +55,37 @@ func DecimalsInBigInt(decimal uint32) *big.Int {
 	return decimalsBig
 }
 
+var lamportsPerSolRat = new(big.Rat).SetInt(new(big.Int).SetUint64(LAMPORTS_PER_SOL))
+
+// LamportsToSol converts the provided amount of lamports to SOL,
+// as an exact rational number.
+func LamportsToSol(lamports uint64) *big.Rat {
+	return new(big.Rat).SetFrac(
+		new(big.Int).SetUint64(lamports),
+		lamportsPerSolRat.Num(),
+	)
+}
+
+// SolToLamports converts the provided amount of SOL to lamports.
+// It returns an error if the amount is negative, is not a whole number of lamports
+// (i.e. it has more than 9 decimal places), or doesn't fit into a uint64.
+func SolToLamports(sol *big.Rat) (uint64, error) {
+	if sol == nil {
+		return 0, errors.New("sol amount is nil")
+	}
+	if sol.Sign() < 0 {
+		return 0, fmt.Errorf("sol amount is negative: %s", sol.FloatString(9))
+	}
+	lamports := new(big.Rat).Mul(sol, lamportsPerSolRat)
+	if !lamports.IsInt() {
+		return 0, fmt.Errorf("sol amount is not an exact number of lamports: %s", sol.RatString())
+	}
+	if !lamports.Num().IsUint64() {
+		return 0, fmt.Errorf("sol amount overflows uint64 lamports: %s", sol.FloatString(9))
+	}
+	return lamports.Num().Uint64(), nil
+}
+
 //
 //func foo(numerator, denomiator *big.Int) {
 //	quotient := new(big.Int).Quo(numerator, denomiator)
@@ -0,0 +1,90 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mustRat(s string) *big.Rat {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		panic(s)
+	}
+	return r
+}
+
+func TestLamportsToSol(t *testing.T) {
+	require.Equal(t, "0.000000000", LamportsToSol(0).FloatString(9))
+	require.Equal(t, "0.000000001", LamportsToSol(1).FloatString(9))
+	require.Equal(t, "1.000000000", LamportsToSol(LAMPORTS_PER_SOL).FloatString(9))
+	require.Equal(t, "1.500000000", LamportsToSol(1500000000).FloatString(9))
+	require.Equal(t, "18446744073.709551615", LamportsToSol(math.MaxUint64).FloatString(9))
+}
+
+func TestSolToLamports(t *testing.T) {
+	cases := []struct {
+		sol      string
+		lamports uint64
+	}{
+		{"0", 0},
+		{"0.000000001", 1},
+		{"1", LAMPORTS_PER_SOL},
+		{"1.5", 1500000000},
+		{"0.1", 100000000},
+		{"3/4", 750000000},
+		{"18446744073.709551615", math.MaxUint64},
+	}
+	for _, c := range cases {
+		got, err := SolToLamports(mustRat(c.sol))
+		require.NoError(t, err, c.sol)
+		require.Equal(t, c.lamports, got, c.sol)
+	}
+
+	for _, lamports := range []uint64{0, 1, 999999999, LAMPORTS_PER_SOL, math.MaxUint64} {
+		got, err := SolToLamports(LamportsToSol(lamports))
+		require.NoError(t, err)
+		require.Equal(t, lamports, got)
+	}
+}
+
+func TestSolToLamports_Errors(t *testing.T) {
+	{
+		_, err := SolToLamports(nil)
+		require.Error(t, err)
+	}
+	{
+		// More than 9 decimal places.
+		_, err := SolToLamports(mustRat("0.0000000001"))
+		require.Error(t, err)
+	}
+	{
+		_, err := SolToLamports(mustRat("1/3"))
+		require.Error(t, err)
+	}
+	{
+		_, err := SolToLamports(mustRat("-1"))
+		require.Error(t, err)
+	}
+	{
+		// One lamport more than math.MaxUint64.
+		_, err := SolToLamports(mustRat("18446744073.709551616"))
+		require.Error(t, err)
+	}
+}